@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+// StubAPIResponses returns an AWS SDK for Go v2 API option that answers every operation
+// with the result and error returned by f, without calling AWS.
+// f is passed the operation's input, e.g. *docdb.ModifyDBClusterParameterGroupInput.
+// Use it in unit tests as a client option: APIOptions: []func(*middleware.Stack) error{acctest.StubAPIResponses(f)}.
+func StubAPIResponses(f func(input any) (any, error)) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("StubAPIResponses", func(_ context.Context, in middleware.InitializeInput, _ middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			result, err := f(in.Parameters)
+
+			return middleware.InitializeOutput{Result: result}, middleware.Metadata{}, err
+		}), middleware.Before)
+	}
+}
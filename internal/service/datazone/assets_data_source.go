@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Assets")
+func newDataSourceAssets(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceAssets{}, nil
+}
+
+const (
+	DSNameAssets = "Assets Data Source"
+)
+
+type dataSourceAssets struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceAssets) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_datazone_assets"
+}
+
+func (d *dataSourceAssets) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"assets": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSourceAssetsAssetModel](ctx),
+				Computed:   true,
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"owning_project_identifier": schema.StringAttribute{
+				Optional: true,
+			},
+			"search_scope": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InventorySearchScope](),
+				Optional:   true,
+				Computed:   true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(awstypes.InventorySearchScopeAsset), string(awstypes.InventorySearchScopeDataProduct)),
+				},
+			},
+			"search_text": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSourceListingFilterModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"attribute": schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceAssets) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().DataZoneClient(ctx)
+
+	var data dataSourceAssetsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainID := data.DomainIdentifier.ValueString()
+	in := &datazone.SearchListingsInput{
+		DomainIdentifier: aws.String(domainID),
+		SearchText:       flex.StringFromFramework(ctx, data.SearchText),
+	}
+
+	filters, diags := data.Filters.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in.Filters = expandListingFilters(filters)
+
+	if data.SearchScope.IsNull() || data.SearchScope.IsUnknown() {
+		data.SearchScope = fwtypes.StringEnumValue(awstypes.InventorySearchScopeAsset)
+	}
+
+	items, err := findSearchListingItems(ctx, conn, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionReading, DSNameAssets, domainID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	assets := flattenAssets(ctx, items, data.SearchScope.ValueEnum(), data.OwningProjectIdentifier.ValueString())
+
+	data.Assets = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, assets)
+	data.ID = types.StringValue(domainID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findSearchListingItems(ctx context.Context, conn *datazone.Client, in *datazone.SearchListingsInput) ([]awstypes.SearchResultItem, error) {
+	var output []awstypes.SearchResultItem
+
+	pages := datazone.NewSearchListingsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+// flattenAssets returns the listing items in the given scope, optionally limited to a single owning project.
+// SearchListings has no project filter, so that is applied here.
+func flattenAssets(ctx context.Context, apiObjects []awstypes.SearchResultItem, scope awstypes.InventorySearchScope, owningProjectID string) []*dataSourceAssetsAssetModel {
+	var tfList []*dataSourceAssetsAssetModel
+
+	for _, apiObject := range apiObjects {
+		var tfObj *dataSourceAssetsAssetModel
+		var projectID *string
+
+		switch v := apiObject.(type) {
+		case *awstypes.SearchResultItemMemberAssetListing:
+			if scope != awstypes.InventorySearchScopeAsset {
+				continue
+			}
+
+			projectID = v.Value.OwningProjectId
+			tfObj = &dataSourceAssetsAssetModel{
+				GlossaryTerms: flattenDetailedGlossaryTermNames(ctx, v.Value.GlossaryTerms),
+				ID:            flex.StringToFramework(ctx, v.Value.EntityId),
+				ListingID:     flex.StringToFramework(ctx, v.Value.ListingId),
+				Name:          flex.StringToFramework(ctx, v.Value.Name),
+				Revision:      flex.StringToFramework(ctx, v.Value.EntityRevision),
+				Type:          flex.StringToFramework(ctx, v.Value.EntityType),
+			}
+		case *awstypes.SearchResultItemMemberDataProductListing:
+			if scope != awstypes.InventorySearchScopeDataProduct {
+				continue
+			}
+
+			projectID = v.Value.OwningProjectId
+			tfObj = &dataSourceAssetsAssetModel{
+				GlossaryTerms: flattenDetailedGlossaryTermNames(ctx, v.Value.GlossaryTerms),
+				ID:            flex.StringToFramework(ctx, v.Value.EntityId),
+				ListingID:     flex.StringToFramework(ctx, v.Value.ListingId),
+				Name:          flex.StringToFramework(ctx, v.Value.Name),
+				Revision:      flex.StringToFramework(ctx, v.Value.EntityRevision),
+				Type:          types.StringValue(string(awstypes.InventorySearchScopeDataProduct)),
+			}
+		default:
+			continue
+		}
+
+		if owningProjectID != "" && aws.ToString(projectID) != owningProjectID {
+			continue
+		}
+
+		tfList = append(tfList, tfObj)
+	}
+
+	return tfList
+}
+
+func flattenDetailedGlossaryTermNames(ctx context.Context, apiObjects []awstypes.DetailedGlossaryTerm) fwtypes.ListValueOf[types.String] {
+	var termNames []string
+
+	for _, v := range apiObjects {
+		termNames = append(termNames, aws.ToString(v.Name))
+	}
+
+	return flex.FlattenFrameworkStringValueListOfString(ctx, termNames)
+}
+
+type dataSourceAssetsModel struct {
+	Assets                  fwtypes.ListNestedObjectValueOf[dataSourceAssetsAssetModel]   `tfsdk:"assets"`
+	DomainIdentifier        types.String                                                  `tfsdk:"domain_identifier"`
+	Filters                 fwtypes.ListNestedObjectValueOf[dataSourceListingFilterModel] `tfsdk:"filter"`
+	ID                      types.String                                                  `tfsdk:"id"`
+	OwningProjectIdentifier types.String                                                  `tfsdk:"owning_project_identifier"`
+	SearchScope             fwtypes.StringEnum[awstypes.InventorySearchScope]             `tfsdk:"search_scope"`
+	SearchText              types.String                                                  `tfsdk:"search_text"`
+}
+
+type dataSourceAssetsAssetModel struct {
+	GlossaryTerms fwtypes.ListValueOf[types.String] `tfsdk:"glossary_terms"`
+	ID            types.String                      `tfsdk:"id"`
+	ListingID     types.String                      `tfsdk:"listing_id"`
+	Name          types.String                      `tfsdk:"name"`
+	Revision      types.String                      `tfsdk:"revision"`
+	Type          types.String                      `tfsdk:"type"`
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneAssetsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_datazone_assets.test"
+	domainName := "aws_datazone_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, domainName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "search_scope", "ASSET"),
+					resource.TestCheckResourceAttr(dataSourceName, "assets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenAssets(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	items := []awstypes.SearchResultItem{
+		&awstypes.SearchResultItemMemberAssetListing{Value: awstypes.AssetListingItem{
+			EntityId:        aws.String("asset1"),
+			EntityType:      aws.String("amazon.datazone.GlueTableAssetType"),
+			GlossaryTerms:   []awstypes.DetailedGlossaryTerm{{Name: aws.String("term1")}},
+			OwningProjectId: aws.String("project1"),
+		}},
+		&awstypes.SearchResultItemMemberAssetListing{Value: awstypes.AssetListingItem{
+			EntityId:        aws.String("asset2"),
+			OwningProjectId: aws.String("project2"),
+		}},
+		&awstypes.SearchResultItemMemberDataProductListing{Value: awstypes.DataProductListingItem{
+			EntityId:        aws.String("product1"),
+			OwningProjectId: aws.String("project1"),
+		}},
+	}
+
+	testCases := map[string]struct {
+		scope    awstypes.InventorySearchScope
+		project  string
+		expected []string
+	}{
+		"assets": {
+			scope:    awstypes.InventorySearchScopeAsset,
+			expected: []string{"asset1", "asset2"},
+		},
+		"assets by project": {
+			scope:    awstypes.InventorySearchScopeAsset,
+			project:  "project1",
+			expected: []string{"asset1"},
+		},
+		"data products": {
+			scope:    awstypes.InventorySearchScopeDataProduct,
+			expected: []string{"product1"},
+		},
+		"no match": {
+			scope:   awstypes.InventorySearchScopeDataProduct,
+			project: "project2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfdatazone.FlattenAssets(ctx, items, testCase.scope, testCase.project)
+
+			if len(got) != len(testCase.expected) {
+				t.Fatalf("got %d assets, expected %d", len(got), len(testCase.expected))
+			}
+
+			for i, v := range got {
+				if id := v.ID.ValueString(); id != testCase.expected[i] {
+					t.Errorf("asset %d ID = %q, expected %q", i, id, testCase.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func testAccAssetsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDomainConfig_basic(rName),
+		fmt.Sprintf(`
+data "aws_datazone_assets" "test" {
+  domain_identifier = aws_datazone_domain.test.id
+  search_text       = %[1]q
+}
+`, rName),
+	)
+}
@@ -18,6 +18,11 @@ func TestAccDataZone_serial(t *testing.T) {
 			acctest.CtDisappears: testAccEnvironment_disappears,
 			"update":             testAccEnvironment_update,
 		},
+		"EnvironmentAction": {
+			acctest.CtBasic:      testAccEnvironmentAction_basic,
+			acctest.CtDisappears: testAccEnvironmentAction_disappears,
+			"update":             testAccEnvironmentAction_update,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -6,13 +6,18 @@ package datazone
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/datazone"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	ssoadmintypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -78,6 +83,12 @@ func (r *resourceDomain) Schema(ctx context.Context, req resource.SchemaRequest,
 			"kms_key_identifier": schema.StringAttribute{
 				CustomType: fwtypes.ARNType,
 				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^arn:[\w-]+:kms:[\w-]+:\d{12}:(key|alias)/.+$`), "must be the ARN of a KMS key or KMS alias"),
+				},
 			},
 			names.AttrName: schema.StringAttribute{
 				Required: true,
@@ -175,6 +186,11 @@ func (r *resourceDomain) Create(ctx context.Context, req resource.CreateRequest,
 		in.SingleSignOn = expandSingleSignOn(tfList)
 	}
 
+	resp.Diagnostics.Append(r.validateSingleSignOn(ctx, in.SingleSignOn)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	outputRaw, err := tfresource.RetryWhenAWSErrCodeContains(ctx, CreateDomainRetryTimeout, func() (interface{}, error) {
 		return conn.CreateDomain(ctx, in)
 	}, ErrorCodeAccessDenied)
@@ -297,6 +313,11 @@ func (r *resourceDomain) Update(ctx context.Context, req resource.UpdateRequest,
 			in.SingleSignOn = expandSingleSignOn(tfList)
 		}
 
+		resp.Diagnostics.Append(r.validateSingleSignOn(ctx, in.SingleSignOn)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		out, err := conn.UpdateDomain(ctx, in)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -369,6 +390,36 @@ func (r *resourceDomain) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 	r.SetTagsAll(ctx, req, resp)
 }
 
+// validateSingleSignOn verifies that an IAM Identity Center instance exists in the
+// configured account and Region when IAM Identity Center single sign-on is requested.
+// Without this check CreateDomain/UpdateDomain fail with an opaque error.
+func (r *resourceDomain) validateSingleSignOn(ctx context.Context, apiObject *awstypes.SingleSignOn) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil || apiObject.Type != awstypes.AuthTypeIamIdc {
+		return diags
+	}
+
+	_, err := findIdentityCenterInstance(ctx, r.Meta().SSOAdminClient(ctx))
+
+	if tfresource.NotFound(err) {
+		diags.AddAttributeError(
+			path.Root("single_sign_on").AtListIndex(0).AtName(names.AttrType),
+			"Missing IAM Identity Center Instance",
+			fmt.Sprintf("single_sign_on type %q requires an IAM Identity Center instance in Region (%s), but none was found. "+
+				"Enable IAM Identity Center in this account and Region or set single_sign_on type to %q.",
+				awstypes.AuthTypeIamIdc, r.Meta().Region, awstypes.AuthTypeDisabled),
+		)
+		return diags
+	}
+
+	if err != nil {
+		diags.AddError("reading IAM Identity Center instances", err.Error())
+	}
+
+	return diags
+}
+
 func waitDomainCreated(ctx context.Context, conn *datazone.Client, id string, timeout time.Duration) (*datazone.GetDomainOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.DomainStatusCreating),
@@ -440,6 +491,25 @@ func findDomainByID(ctx context.Context, conn *datazone.Client, id string) (*dat
 	return out, nil
 }
 
+func findIdentityCenterInstance(ctx context.Context, conn *ssoadmin.Client) (*ssoadmintypes.InstanceMetadata, error) {
+	input := &ssoadmin.ListInstancesInput{}
+
+	pages := ssoadmin.NewListInstancesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page.Instances) > 0 {
+			return &page.Instances[0], nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
 func flattenSingleSignOn(ctx context.Context, apiObject *awstypes.SingleSignOn) (types.List, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	elemType := types.ObjectType{AttrTypes: singleSignOnAttrTypes}
@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -88,7 +90,7 @@ func TestAccDataZoneDomain_disappears(t *testing.T) {
 func TestAccDataZoneDomain_kms_key_identifier(t *testing.T) {
 	ctx := acctest.Context(t)
 
-	var domain datazone.GetDomainOutput
+	var domain, domain2 datazone.GetDomainOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datazone_domain.test"
 
@@ -102,11 +104,11 @@ func TestAccDataZoneDomain_kms_key_identifier(t *testing.T) {
 		CheckDestroy:             testAccCheckDomainDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDomainConfig_kms_key_identifier(rName),
+				Config: testAccDomainConfig_kms_key_identifier(rName, 0),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainExists(ctx, resourceName, &domain),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
-					resource.TestCheckResourceAttrSet(resourceName, "kms_key_identifier"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_identifier", "aws_kms_key.test.0", names.AttrARN),
 				),
 			},
 			{
@@ -115,6 +117,34 @@ func TestAccDataZoneDomain_kms_key_identifier(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{names.AttrApplyImmediately, "user", "skip_deletion_check"},
 			},
+			{
+				Config: testAccDomainConfig_kms_key_identifier(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain2),
+					testAccCheckDomainRecreated(&domain, &domain2),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_identifier", "aws_kms_key.test.1", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneDomain_kmsKeyIdentifierInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainConfig_kmsKeyIdentifierInvalid(rName),
+				ExpectError: regexache.MustCompile(`must be the ARN of a KMS key or KMS alias`),
+			},
 		},
 	})
 }
@@ -188,6 +218,29 @@ func TestAccDataZoneDomain_single_sign_on(t *testing.T) {
 	})
 }
 
+func TestAccDataZoneDomain_singleSignOnIdentityCenterNoInstance(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			testAccPreCheckNoSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainConfig_singleSignOnIdentityCenter(rName),
+				ExpectError: regexache.MustCompile(`Missing IAM Identity Center Instance`),
+			},
+		},
+	})
+}
+
 func TestAccDataZoneDomain_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -292,6 +345,16 @@ func testAccCheckDomainExists(ctx context.Context, name string, domain *datazone
 	}
 }
 
+func testAccCheckDomainRecreated(before, after *datazone.GetDomainOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.Id), aws.ToString(after.Id); before == after {
+			return create.Error(names.DataZone, create.ErrActionCheckingRecreated, tfdatazone.ResNameDomain, before, errors.New("not recreated"))
+		}
+
+		return nil
+	}
+}
+
 func testAccPreCheck(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
 
@@ -306,6 +369,23 @@ func testAccPreCheck(ctx context.Context, t *testing.T) {
 	}
 }
 
+func testAccPreCheckNoSSOAdminInstances(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
+
+	output, err := conn.ListInstances(ctx, &ssoadmin.ListInstancesInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+
+	if len(output.Instances) > 0 {
+		t.Skip("skipping acceptance testing; SSO Instances found")
+	}
+}
+
 func testAccDomainConfigDomainExecutionRole(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "domain_execution_role" {
@@ -364,23 +444,39 @@ resource "aws_datazone_domain" "test" {
 	)
 }
 
-func testAccDomainConfig_kms_key_identifier(rName string) string {
+func testAccDomainConfig_kms_key_identifier(rName string, index int) string {
 	return acctest.ConfigCompose(
 		testAccDomainConfigDomainExecutionRole(rName),
 		fmt.Sprintf(`
 resource "aws_kms_key" "test" {
+  count = 2
+
   deletion_window_in_days = 7
 }
 
 resource "aws_datazone_domain" "test" {
   name                  = %[1]q
   domain_execution_role = aws_iam_role.domain_execution_role.arn
-  kms_key_identifier    = aws_kms_key.test.arn
+  kms_key_identifier    = aws_kms_key.test[%[2]d].arn
 }
-`, rName),
+`, rName, index),
 	)
 }
 
+func testAccDomainConfig_kmsKeyIdentifierInvalid(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_datazone_domain" "test" {
+  name                  = %[1]q
+  domain_execution_role = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/%[1]s"
+  kms_key_identifier    = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/%[1]s"
+}
+`, rName)
+}
+
 func testAccDomainConfig_description(rName, description string) string {
 	return acctest.ConfigCompose(
 		testAccDomainConfigDomainExecutionRole(rName),
@@ -409,6 +505,22 @@ resource "aws_datazone_domain" "test" {
 	)
 }
 
+func testAccDomainConfig_singleSignOnIdentityCenter(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDomainConfigDomainExecutionRole(rName),
+		fmt.Sprintf(`
+resource "aws_datazone_domain" "test" {
+  name                  = %[1]q
+  domain_execution_role = aws_iam_role.domain_execution_role.arn
+  single_sign_on {
+    type            = "IAM_IDC"
+    user_assignment = "AUTOMATIC"
+  }
+}
+`, rName),
+	)
+}
+
 func testAccDomainConfig_tags(rName, tagKey, tagValue string) string {
 	return acctest.ConfigCompose(
 		testAccDomainConfigDomainExecutionRole(rName),
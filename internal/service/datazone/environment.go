@@ -383,7 +383,8 @@ func waitEnvironmentCreated(ctx context.Context, conn *datazone.Client, domainId
 		Target:                    enum.Slice[awstypes.EnvironmentStatus](awstypes.EnvironmentStatusActive),
 		Refresh:                   statusEnvironment(ctx, conn, domainId, id),
 		Timeout:                   timeout,
-		NotFoundChecks:            20,
+		PollInterval:              waiterPollInterval(timeout),
+		NotFoundChecks:            waiterNotFoundChecks(timeout),
 		ContinuousTargetOccurence: 2,
 	}
 
@@ -404,7 +405,8 @@ func waitEnvironmentUpdated(ctx context.Context, conn *datazone.Client, domainId
 		Target:                    enum.Slice[awstypes.EnvironmentStatus](awstypes.EnvironmentStatusActive),
 		Refresh:                   statusEnvironment(ctx, conn, domainId, id),
 		Timeout:                   timeout,
-		NotFoundChecks:            20,
+		PollInterval:              waiterPollInterval(timeout),
+		NotFoundChecks:            waiterNotFoundChecks(timeout),
 		ContinuousTargetOccurence: 2,
 	}
 
@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_datazone_environment_action", name="Environment Action")
+func newResourceEnvironmentAction(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceEnvironmentAction{}, nil
+}
+
+const (
+	ResNameEnvironmentAction = "Environment Action"
+)
+
+type resourceEnvironmentAction struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceEnvironmentAction) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_environment_action"
+}
+
+func (r *resourceEnvironmentAction) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^dzd[-_][a-zA-Z0-9_-]{1,36}$`), "must conform to: ^dzd[-_][a-zA-Z0-9_-]{1,36}$ "),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_identifier": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9_-]{1,36}$`), "must conform to: ^[a-zA-Z0-9_-]{1,36}$"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrParameters: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[actionParametersData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrURI: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceEnvironmentAction) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan environmentActionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateEnvironmentActionInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, &plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.CreateEnvironmentAction(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameEnvironmentAction, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.Id == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameEnvironmentAction, plan.Name.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceEnvironmentAction) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state environmentActionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findEnvironmentActionByID(ctx, conn, state.DomainIdentifier.ValueString(), state.EnvironmentIdentifier.ValueString(), state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionReading, ResNameEnvironmentAction, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	description := state.Description
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Description = normalizeEnvironmentActionDescription(description, state.Description)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEnvironmentAction) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan, state environmentActionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) ||
+		!plan.Name.Equal(state.Name) ||
+		!plan.Parameters.Equal(state.Parameters) {
+		in := &datazone.UpdateEnvironmentActionInput{}
+		resp.Diagnostics.Append(flex.Expand(ctx, &plan, in)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		in.Identifier = state.ID.ValueStringPointer()
+		// A nil description leaves the current description in place.
+		if plan.Description.IsNull() && !state.Description.IsNull() {
+			in.Description = aws.String("")
+		}
+
+		out, err := conn.UpdateEnvironmentAction(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameEnvironmentAction, state.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+		if out == nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameEnvironmentAction, state.ID.String(), nil),
+				errors.New("empty output").Error(),
+			)
+			return
+		}
+
+		description := plan.Description
+		resp.Diagnostics.Append(flex.Flatten(ctx, out, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.Description = normalizeEnvironmentActionDescription(description, plan.Description)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceEnvironmentAction) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state environmentActionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteEnvironmentAction(ctx, &datazone.DeleteEnvironmentActionInput{
+		DomainIdentifier:      state.DomainIdentifier.ValueStringPointer(),
+		EnvironmentIdentifier: state.EnvironmentIdentifier.ValueStringPointer(),
+		Identifier:            state.ID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameEnvironmentAction, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceEnvironmentAction) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError("Resource Import Invalid ID", fmt.Sprintf(`Unexpected format for import ID (%s), use: "DomainIdentifier:EnvironmentIdentifier:Id"`, req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_identifier"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), parts[2])...)
+}
+
+func findEnvironmentActionByID(ctx context.Context, conn *datazone.Client, domainID, environmentID, id string) (*datazone.GetEnvironmentActionOutput, error) {
+	in := &datazone.GetEnvironmentActionInput{
+		DomainIdentifier:      aws.String(domainID),
+		EnvironmentIdentifier: aws.String(environmentID),
+		Identifier:            aws.String(id),
+	}
+
+	out, err := conn.GetEnvironmentAction(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+// normalizeEnvironmentActionDescription reports a description that has been cleared as null
+// rather than the empty string the API returns, when no prior description is set.
+func normalizeEnvironmentActionDescription(prior, current types.String) types.String {
+	if prior.IsNull() && (current.IsNull() || current.ValueString() == "") {
+		return prior
+	}
+
+	return current
+}
+
+type environmentActionData struct {
+	Description           types.String                                          `tfsdk:"description"`
+	DomainIdentifier      types.String                                          `tfsdk:"domain_identifier"`
+	EnvironmentIdentifier types.String                                          `tfsdk:"environment_identifier"`
+	ID                    types.String                                          `tfsdk:"id"`
+	Name                  types.String                                          `tfsdk:"name"`
+	Parameters            fwtypes.ListNestedObjectValueOf[actionParametersData] `tfsdk:"parameters"`
+}
+
+type actionParametersData struct {
+	URI types.String `tfsdk:"uri"`
+}
+
+var (
+	_ flex.Expander  = actionParametersData{}
+	_ flex.Flattener = &actionParametersData{}
+)
+
+func (m actionParametersData) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	return &awstypes.ActionParametersMemberAwsConsoleLink{
+		Value: awstypes.AwsConsoleLinkParameters{
+			Uri: m.URI.ValueStringPointer(),
+		},
+	}, diags
+}
+
+func (m *actionParametersData) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ActionParametersMemberAwsConsoleLink:
+		m.URI = flex.StringToFramework(ctx, t.Value.Uri)
+	case *awstypes.ActionParametersMemberAwsConsoleLink:
+		m.URI = flex.StringToFramework(ctx, t.Value.Uri)
+	}
+
+	return diags
+}
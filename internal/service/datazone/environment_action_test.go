@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccEnvironmentAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var environmentAction datazone.GetEnvironmentActionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_action.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DataZoneEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentActionConfig_basic(rName, rName, "https://console.aws.amazon.com/athena"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentActionExists(ctx, resourceName, &environmentAction),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_identifier", "aws_datazone_domain.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_identifier", "aws_datazone_environment.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.uri", "https://console.aws.amazon.com/athena"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccEnvironmentActionImportStateFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentAction_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var environmentAction datazone.GetEnvironmentActionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_action.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DataZoneEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentActionConfig_basic(rName, rName, "https://console.aws.amazon.com/athena"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentActionExists(ctx, resourceName, &environmentAction),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceEnvironmentAction, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccEnvironmentAction_update(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var environmentAction1, environmentAction2 datazone.GetEnvironmentActionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := fmt.Sprintf("%s-update", rName)
+	resourceName := "aws_datazone_environment_action.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DataZoneEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentActionConfig_basic(rName, rName, "https://console.aws.amazon.com/athena"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentActionExists(ctx, resourceName, &environmentAction1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.uri", "https://console.aws.amazon.com/athena"),
+				),
+			},
+			{
+				Config: testAccEnvironmentActionConfig_basic(rName, rNameUpdated, "https://console.aws.amazon.com/redshiftv2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentActionExists(ctx, resourceName, &environmentAction2),
+					testAccCheckEnvironmentActionNotRecreated(&environmentAction1, &environmentAction2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.uri", "https://console.aws.amazon.com/redshiftv2"),
+				),
+			},
+			{
+				Config: testAccEnvironmentActionConfig_noDescription(rName, rNameUpdated, "https://console.aws.amazon.com/redshiftv2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentActionExists(ctx, resourceName, &environmentAction2),
+					testAccCheckEnvironmentActionNotRecreated(&environmentAction1, &environmentAction2),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+				),
+			},
+		},
+	})
+}
+
+func TestNormalizeEnvironmentActionDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior, current, expected basetypes.StringValue
+	}{
+		"cleared": {
+			prior:    basetypes.NewStringNull(),
+			current:  basetypes.NewStringValue(""),
+			expected: basetypes.NewStringNull(),
+		},
+		"drift": {
+			prior:    basetypes.NewStringNull(),
+			current:  basetypes.NewStringValue("desc"),
+			expected: basetypes.NewStringValue("desc"),
+		},
+		"unchanged": {
+			prior:    basetypes.NewStringValue("desc"),
+			current:  basetypes.NewStringValue("desc"),
+			expected: basetypes.NewStringValue("desc"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfdatazone.NormalizeEnvironmentActionDescription(testCase.prior, testCase.current), testCase.expected; !got.Equal(want) {
+				t.Errorf("NormalizeEnvironmentActionDescription() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func testAccEnvironmentActionImportStateFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return strings.Join([]string{rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["environment_identifier"], rs.Primary.ID}, ":"), nil
+	}
+}
+
+func testAccCheckEnvironmentActionExists(ctx context.Context, name string, environmentAction *datazone.GetEnvironmentActionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameEnvironmentAction, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameEnvironmentAction, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+		resp, err := tfdatazone.FindEnvironmentActionByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["environment_identifier"], rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameEnvironmentAction, rs.Primary.ID, err)
+		}
+
+		*environmentAction = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentActionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_environment_action" {
+				continue
+			}
+
+			_, err := tfdatazone.FindEnvironmentActionByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["environment_identifier"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameEnvironmentAction, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameEnvironmentAction, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentActionNotRecreated(before, after *datazone.GetEnvironmentActionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.Id), aws.ToString(after.Id); before != after {
+			return create.Error(names.DataZone, create.ErrActionCheckingNotRecreated, tfdatazone.ResNameEnvironmentAction, before, errors.New("recreated"))
+		}
+
+		return nil
+	}
+}
+
+func testAccEnvironmentActionConfig_basic(rName, actionName, uri string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_basic(rName), fmt.Sprintf(`
+resource "aws_datazone_environment_action" "test" {
+  domain_identifier      = aws_datazone_domain.test.id
+  environment_identifier = aws_datazone_environment.test.id
+  name                   = %[1]q
+  description            = %[1]q
+
+  parameters {
+    uri = %[2]q
+  }
+}
+`, actionName, uri))
+}
+
+func testAccEnvironmentActionConfig_noDescription(rName, actionName, uri string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_basic(rName), fmt.Sprintf(`
+resource "aws_datazone_environment_action" "test" {
+  domain_identifier      = aws_datazone_domain.test.id
+  environment_identifier = aws_datazone_environment.test.id
+  name                   = %[1]q
+
+  parameters {
+    uri = %[2]q
+  }
+}
+`, actionName, uri))
+}
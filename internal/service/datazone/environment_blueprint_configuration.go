@@ -9,8 +9,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -81,6 +86,41 @@ func (r *resourceEnvironmentBlueprintConfiguration) Schema(ctx context.Context,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"provisioning_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[provisioningConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"lake_formation_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[lakeFormationConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"location_registration_exclude_s3_locations": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(20),
+											listvalidator.ValueStringsAre(
+												stringvalidator.RegexMatches(regexache.MustCompile(`^s3://.+$`), "must be an Amazon S3 URI"),
+											),
+										},
+									},
+									"location_registration_role": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Optional:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -117,6 +157,16 @@ func (r *resourceEnvironmentBlueprintConfiguration) Create(ctx context.Context,
 		in.RegionalParameters = tfMap
 	}
 
+	if !plan.ProvisioningConfiguration.IsNull() {
+		provisioningConfigurations, d := expandProvisioningConfigurations(ctx, plan.ProvisioningConfiguration)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		in.ProvisioningConfigurations = provisioningConfigurations
+	}
+
 	out, err := conn.PutEnvironmentBlueprintConfiguration(ctx, in)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -165,6 +215,10 @@ func (r *resourceEnvironmentBlueprintConfiguration) Read(ctx context.Context, re
 	state.ManageAccessRoleArn = flex.StringToFrameworkARN(ctx, out.ManageAccessRoleArn)
 	state.ProvisioningRoleArn = flex.StringToFrameworkARN(ctx, out.ProvisioningRoleArn)
 
+	provisioningConfiguration, d := flattenProvisioningConfigurations(ctx, out.ProvisioningConfigurations)
+	resp.Diagnostics.Append(d...)
+	state.ProvisioningConfiguration = provisioningConfiguration
+
 	regionalParameters, d := flattenRegionalParameters(ctx, &out.RegionalParameters)
 	resp.Diagnostics.Append(d...)
 	state.RegionalParameters = regionalParameters
@@ -184,6 +238,7 @@ func (r *resourceEnvironmentBlueprintConfiguration) Update(ctx context.Context,
 
 	if !plan.EnabledRegions.Equal(state.EnabledRegions) ||
 		!plan.ManageAccessRoleArn.Equal(state.ManageAccessRoleArn) ||
+		!plan.ProvisioningConfiguration.Equal(state.ProvisioningConfiguration) ||
 		!plan.ProvisioningRoleArn.Equal(state.ProvisioningRoleArn) ||
 		!plan.RegionalParameters.Equal(state.RegionalParameters) {
 		in := &datazone.PutEnvironmentBlueprintConfigurationInput{
@@ -210,6 +265,19 @@ func (r *resourceEnvironmentBlueprintConfiguration) Update(ctx context.Context,
 			in.RegionalParameters = tfMap
 		}
 
+		if !plan.ProvisioningConfiguration.IsNull() {
+			provisioningConfigurations, d := expandProvisioningConfigurations(ctx, plan.ProvisioningConfiguration)
+			resp.Diagnostics.Append(d...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			in.ProvisioningConfigurations = provisioningConfigurations
+		} else if !state.ProvisioningConfiguration.IsNull() {
+			// Send an explicit empty list so that removing the block clears the provisioning configurations.
+			in.ProvisioningConfigurations = []awstypes.ProvisioningConfiguration{}
+		}
+
 		out, err := conn.PutEnvironmentBlueprintConfiguration(ctx, in)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -323,6 +391,70 @@ func flattenRegionalParameters(ctx context.Context, apiObject *map[string]map[st
 	return mapVal, diags
 }
 
+func expandProvisioningConfigurations(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[provisioningConfigurationModel]) ([]awstypes.ProvisioningConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([]awstypes.ProvisioningConfiguration, 0, len(data))
+
+	for _, tfObj := range data {
+		lakeFormationConfiguration, d := tfObj.LakeFormationConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if lakeFormationConfiguration == nil {
+			continue
+		}
+
+		apiObject := &awstypes.ProvisioningConfigurationMemberLakeFormationConfiguration{
+			Value: awstypes.LakeFormationConfiguration{
+				LocationRegistrationExcludeS3Locations: flex.ExpandFrameworkStringValueList(ctx, lakeFormationConfiguration.LocationRegistrationExcludeS3Locations),
+				LocationRegistrationRole:               lakeFormationConfiguration.LocationRegistrationRole.ValueStringPointer(),
+			},
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, diags
+}
+
+func flattenProvisioningConfigurations(ctx context.Context, apiObjects []awstypes.ProvisioningConfiguration) (fwtypes.ListNestedObjectValueOf[provisioningConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(apiObjects) == 0 {
+		return fwtypes.NewListNestedObjectValueOfNull[provisioningConfigurationModel](ctx), diags
+	}
+
+	tfList := make([]provisioningConfigurationModel, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		switch v := apiObject.(type) {
+		case *awstypes.ProvisioningConfigurationMemberLakeFormationConfiguration:
+			tfList = append(tfList, provisioningConfigurationModel{
+				LakeFormationConfiguration: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &lakeFormationConfigurationModel{
+					LocationRegistrationExcludeS3Locations: flex.FlattenFrameworkStringValueListOfString(ctx, v.Value.LocationRegistrationExcludeS3Locations),
+					LocationRegistrationRole:               flex.StringToFrameworkARN(ctx, v.Value.LocationRegistrationRole),
+				}),
+			})
+		default:
+			diags.AddError(
+				"Unsupported provisioning configuration",
+				fmt.Sprintf("unsupported provisioning configuration type: %T", v),
+			)
+		}
+	}
+
+	return fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, tfList), diags
+}
+
 func flattenEnabledRegions(ctx context.Context, apiList []string) basetypes.ListValue {
 	// When the list returned from the api is empty, return empty list rather than the
 	// default flatten result of null for empty lists.
@@ -333,10 +465,20 @@ func flattenEnabledRegions(ctx context.Context, apiList []string) basetypes.List
 }
 
 type environmentBlueprintConfigurationResourceModel struct {
-	DomainId               types.String `tfsdk:"domain_id"`
-	EnabledRegions         types.List   `tfsdk:"enabled_regions"`
-	EnvironmentBlueprintId types.String `tfsdk:"environment_blueprint_id"`
-	ManageAccessRoleArn    fwtypes.ARN  `tfsdk:"manage_access_role_arn"`
-	ProvisioningRoleArn    fwtypes.ARN  `tfsdk:"provisioning_role_arn"`
-	RegionalParameters     types.Map    `tfsdk:"regional_parameters"`
+	DomainId                  types.String                                                    `tfsdk:"domain_id"`
+	EnabledRegions            types.List                                                      `tfsdk:"enabled_regions"`
+	EnvironmentBlueprintId    types.String                                                    `tfsdk:"environment_blueprint_id"`
+	ManageAccessRoleArn       fwtypes.ARN                                                     `tfsdk:"manage_access_role_arn"`
+	ProvisioningConfiguration fwtypes.ListNestedObjectValueOf[provisioningConfigurationModel] `tfsdk:"provisioning_configuration"`
+	ProvisioningRoleArn       fwtypes.ARN                                                     `tfsdk:"provisioning_role_arn"`
+	RegionalParameters        types.Map                                                       `tfsdk:"regional_parameters"`
+}
+
+type provisioningConfigurationModel struct {
+	LakeFormationConfiguration fwtypes.ListNestedObjectValueOf[lakeFormationConfigurationModel] `tfsdk:"lake_formation_configuration"`
+}
+
+type lakeFormationConfigurationModel struct {
+	LocationRegistrationExcludeS3Locations fwtypes.ListValueOf[types.String] `tfsdk:"location_registration_exclude_s3_locations"`
+	LocationRegistrationRole               fwtypes.ARN                       `tfsdk:"location_registration_role"`
 }
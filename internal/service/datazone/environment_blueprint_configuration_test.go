@@ -273,6 +273,59 @@ func TestAccDataZoneEnvironmentBlueprintConfiguration_regional_parameters(t *tes
 	})
 }
 
+func TestAccDataZoneEnvironmentBlueprintConfiguration_provisioning_configuration(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var environmentblueprintconfiguration datazone.GetEnvironmentBlueprintConfigurationOutput
+	domainName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_blueprint_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentBlueprintConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentBlueprintConfigurationConfig_provisioning_configuration(domainName, "s3://"+domainName+"/one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentBlueprintConfigurationExists(ctx, resourceName, &environmentblueprintconfiguration),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_configuration.0.lake_formation_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "provisioning_configuration.0.lake_formation_configuration.0.location_registration_role", "aws_iam_role.domain_execution_role", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_configuration.0.lake_formation_configuration.0.location_registration_exclude_s3_locations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_configuration.0.lake_formation_configuration.0.location_registration_exclude_s3_locations.0", "s3://"+domainName+"/one"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccEnvironmentBlueprintConfigurationImportStateIdFunc(resourceName),
+				ImportStateVerifyIdentifierAttribute: "environment_blueprint_id",
+			},
+			{
+				Config: testAccEnvironmentBlueprintConfigurationConfig_provisioning_configuration(domainName, "s3://"+domainName+"/two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentBlueprintConfigurationExists(ctx, resourceName, &environmentblueprintconfiguration),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_configuration.0.lake_formation_configuration.0.location_registration_exclude_s3_locations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_configuration.0.lake_formation_configuration.0.location_registration_exclude_s3_locations.0", "s3://"+domainName+"/two"),
+				),
+			},
+			{
+				Config: testAccEnvironmentBlueprintConfigurationConfig_basic(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentBlueprintConfigurationExists(ctx, resourceName, &environmentblueprintconfiguration),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEnvironmentBlueprintConfigurationExists(ctx context.Context, name string, environmentblueprintconfiguration *datazone.GetEnvironmentBlueprintConfigurationOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -382,3 +435,23 @@ resource "aws_datazone_environment_blueprint_configuration" "test" {
 `, region, key, value),
 	)
 }
+
+func testAccEnvironmentBlueprintConfigurationConfig_provisioning_configuration(domainName, excludeS3Location string) string {
+	return acctest.ConfigCompose(
+		testAccEnvironmentBlueprintDataSourceConfig_basic(domainName),
+		fmt.Sprintf(`
+resource "aws_datazone_environment_blueprint_configuration" "test" {
+  domain_id                = aws_datazone_domain.test.id
+  environment_blueprint_id = data.aws_datazone_environment_blueprint.test.id
+  enabled_regions          = []
+
+  provisioning_configuration {
+    lake_formation_configuration {
+      location_registration_role                 = aws_iam_role.domain_execution_role.arn
+      location_registration_exclude_s3_locations = [%[1]q]
+    }
+  }
+}
+`, excludeS3Location),
+	)
+}
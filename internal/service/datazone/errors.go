@@ -4,7 +4,10 @@
 package datazone
 
 import (
+	"net/http"
+
 	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
@@ -17,3 +20,26 @@ func isResourceMissing(err error) bool {
 	// AccessDeniedException: User is not permitted to perform operation: GetDomain
 	return errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsAErrorMessageContains[*awstypes.AccessDeniedException](err, "is not permitted to perform")
 }
+
+// isThrottlingOrTransientError returns whether the error is a DataZone throttling error
+// or a transient server-side error that is safe to retry.
+func isThrottlingOrTransientError(err error) bool {
+	return errs.IsA[*awstypes.ThrottlingException](err) ||
+		errs.IsA[*awstypes.InternalServerException](err) ||
+		tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout)
+}
+
+// isProjectHasChildResourcesError returns whether the error indicates that a project could not be
+// deleted because it still contains child resources, such as assets, environments or data sources.
+// Such projects can only be deleted with SkipDeletionCheck set.
+// DataZone returns a ConflictException or a ValidationException, depending on the child resource,
+// and the message is checked as other conflicts, e.g. a concurrent modification, can't be fixed that way.
+func isProjectHasChildResourcesError(err error) bool {
+	for _, message := range []string{"child resources", "skipDeletionCheck"} {
+		if errs.IsAErrorMessageContains[*awstypes.ConflictException](err, message) || errs.IsAErrorMessageContains[*awstypes.ValidationException](err, message) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+)
+
+func TestIsThrottlingOrTransientError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil": {
+			err: nil,
+		},
+		"throttling": {
+			err:      &awstypes.ThrottlingException{Message: aws.String("Rate exceeded")},
+			expected: true,
+		},
+		"internal server error": {
+			err:      &awstypes.InternalServerException{Message: aws.String("Internal failure")},
+			expected: true,
+		},
+		"validation": {
+			err: &awstypes.ValidationException{Message: aws.String("Invalid name")},
+		},
+		"not found": {
+			err: &awstypes.ResourceNotFoundException{Message: aws.String("Project not found")},
+		},
+		"other": {
+			err: errors.New("boom"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfdatazone.IsThrottlingOrTransientError(testCase.err), testCase.expected; got != want {
+				t.Errorf("IsThrottlingOrTransientError(%v) = %t, want %t", testCase.err, got, want)
+			}
+		})
+	}
+}
+
+func TestIsProjectHasChildResourcesError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil": {
+			err: nil,
+		},
+		"conflict": {
+			err:      &awstypes.ConflictException{Message: aws.String("Project has child resources")},
+			expected: true,
+		},
+		"conflict skip deletion check": {
+			err:      &awstypes.ConflictException{Message: aws.String("Project contains environments. Use skipDeletionCheck to delete it")},
+			expected: true,
+		},
+		"conflict other": {
+			err: &awstypes.ConflictException{Message: aws.String("Another operation is in progress on the project")},
+		},
+		"validation skip deletion check": {
+			err:      &awstypes.ValidationException{Message: aws.String("Project is not empty. Use skipDeletionCheck to delete it")},
+			expected: true,
+		},
+		"validation": {
+			err: &awstypes.ValidationException{Message: aws.String("Invalid name")},
+		},
+		"not found": {
+			err: &awstypes.ResourceNotFoundException{Message: aws.String("Project not found")},
+		},
+		"other": {
+			err: errors.New("boom"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfdatazone.IsProjectHasChildResources(testCase.err), testCase.expected; got != want {
+				t.Errorf("IsProjectHasChildResources(%v) = %t, want %t", testCase.err, got, want)
+			}
+		})
+	}
+}
+
+func TestRetryProjectWhenThrottled(t *testing.T) {
+	t.Parallel()
+
+	t.Run("succeeds after throttling", func(t *testing.T) {
+		t.Parallel()
+
+		attempts := 0
+		out, err := tfdatazone.RetryProjectWhenThrottled(context.Background(), 1*time.Minute, func() (*datazone.GetProjectOutput, error) {
+			attempts++
+			switch attempts {
+			case 1:
+				return nil, &awstypes.ThrottlingException{Message: aws.String("Rate exceeded")}
+			case 2:
+				return nil, &awstypes.InternalServerException{Message: aws.String("Internal failure")}
+			default:
+				return &datazone.GetProjectOutput{Id: aws.String("test")}, nil
+			}
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got, want := aws.ToString(out.Id), "test"; got != want {
+			t.Errorf("Id = %q, want %q", got, want)
+		}
+		if got, want := attempts, 3; got != want {
+			t.Errorf("attempts = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		t.Parallel()
+
+		attempts := 0
+		_, err := tfdatazone.RetryProjectWhenThrottled(context.Background(), 1*time.Minute, func() (*datazone.GetProjectOutput, error) {
+			attempts++
+			return nil, &awstypes.ValidationException{Message: aws.String("Invalid name")}
+		})
+
+		if err == nil {
+			t.Fatal("expected error, got none")
+		}
+		if got, want := attempts, 1; got != want {
+			t.Errorf("attempts = %d, want %d", got, want)
+		}
+	})
+}
@@ -3,26 +3,68 @@
 
 package datazone
 
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
 // Exports for use in tests only.
 var (
 	ResourceAssetType                         = newResourceAssetType
 	ResourceDomain                            = newResourceDomain
+	ResourceEnvironmentAction                 = newResourceEnvironmentAction
 	ResourceEnvironmentBlueprintConfiguration = newResourceEnvironmentBlueprintConfiguration
 	ResourceEnvironment                       = newResourceEnvironment
 	ResourceEnvironmentProfile                = newResourceEnvironmentProfile
 	ResourceFormType                          = newResourceFormType
 	ResourceGlossary                          = newResourceGlossary
 	ResourceGlossaryTerm                      = newResourceGlossaryTerm
+	ResourceGlossaryTerms                     = newResourceGlossaryTerms
 	ResourceProject                           = newResourceProject
 	ResourceUserProfile                       = newResourceUserProfile
 
 	FindAssetTypeByID          = findAssetTypeByID
+	FindEnvironmentActionByID  = findEnvironmentActionByID
 	FindEnvironmentByID        = findEnvironmentByID
 	FindEnvironmentProfileByID = findEnvironmentProfileByID
 	FindFormTypeByID           = findFormTypeByID
 	FindGlossaryByID           = findGlossaryByID
 	FindGlossaryTermByID       = findGlossaryTermByID
+	FindListingByID            = findListingByID
 	FindUserProfileByID        = findUserProfileByID
 
-	IsResourceMissing = isResourceMissing
+	CheckDomainAvailable                  = checkDomainAvailable
+	CheckProjectStatus                    = checkProjectStatus
+	FlattenAssets                         = flattenAssets
+	IsProjectHasChildResources            = isProjectHasChildResourcesError
+	IsResourceMissing                     = isResourceMissing
+	IsThrottlingOrTransientError          = isThrottlingOrTransientError
+	NormalizeEnvironmentActionDescription = normalizeEnvironmentActionDescription
+	NormalizeProjectDescription           = normalizeProjectDescription
+	RegionNotAvailableMiddleware          = regionNotAvailableMiddleware
+	StatusProject                         = statusProject
+	WaitProjectDeleted                    = waitProjectDeleted
+	WaiterNotFoundChecks                  = waiterNotFoundChecks
+	WaiterPollInterval                    = waiterPollInterval
 )
+
+func RetryProjectWhenThrottled[T any](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
+	return retryProjectWhenThrottled(ctx, timeout, f)
+}
+
+func CreateGlossaryTerms(ctx context.Context, conn *datazone.Client, domainID, glossaryID string, names ...string) (map[string]string, error) {
+	var terms []*glossaryTermsTermData
+	for _, name := range names {
+		terms = append(terms, &glossaryTermsTermData{
+			Name:   types.StringValue(name),
+			Status: fwtypes.StringEnumValue(awstypes.GlossaryTermStatusEnabled),
+		})
+	}
+
+	return createGlossaryTerms(ctx, conn, domainID, glossaryID, terms)
+}
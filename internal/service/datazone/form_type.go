@@ -286,7 +286,9 @@ func findFormTypeByID(ctx context.Context, conn *datazone.Client, domainId strin
 	in := &datazone.GetFormTypeInput{
 		DomainIdentifier:   aws.String(domainId),
 		FormTypeIdentifier: aws.String(name),
-		Revision:           aws.String(revision),
+	}
+	if revision != "" {
+		in.Revision = aws.String(revision)
 	}
 
 	out, err := conn.GetFormType(ctx, in)
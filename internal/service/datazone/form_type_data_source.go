@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Form Type")
+func newDataSourceFormType(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceFormType{}, nil
+}
+
+const (
+	DSNameFormType = "Form Type Data Source"
+)
+
+type dataSourceFormType struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceFormType) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_datazone_form_type"
+}
+
+func (d *dataSourceFormType) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^dzd[-_][a-zA-Z0-9_-]{1,36}$`), "^dzd[-_][a-zA-Z0-9_-]{1,36}$"),
+				},
+			},
+			"form_type_identifier": schema.StringAttribute{
+				Required: true,
+			},
+			"imports": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[importData](ctx),
+				Computed:   true,
+			},
+			"model": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[modelData](ctx),
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			"origin_domain_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"origin_project_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"owning_project_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"revision": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FormTypeStatus](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceFormType) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().DataZoneClient(ctx)
+
+	var data dataSourceFormTypeModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	formTypeID := data.FormTypeIdentifier.ValueString()
+	out, err := findFormTypeByID(ctx, conn, data.DomainIdentifier.ValueString(), formTypeID, data.Revision.ValueString())
+	if err != nil {
+		err = tfresource.SingularDataSourceFindError("DataZone Form Type", err)
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionReading, DSNameFormType, formTypeID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.CreatedAt = timetypes.NewRFC3339TimePointerValue(out.CreatedAt)
+	data.CreatedBy = flex.StringToFramework(ctx, out.CreatedBy)
+	data.Description = flex.StringToFramework(ctx, out.Description)
+	data.Imports = flattenFormTypeImports(ctx, out.Imports)
+	data.Model = flattenFormTypeModel(ctx, out.Model)
+	data.Name = flex.StringToFramework(ctx, out.Name)
+	data.OriginDomainID = flex.StringToFramework(ctx, out.OriginDomainId)
+	data.OriginProjectID = flex.StringToFramework(ctx, out.OriginProjectId)
+	data.OwningProjectID = flex.StringToFramework(ctx, out.OwningProjectId)
+	data.Revision = flex.StringToFramework(ctx, out.Revision)
+	data.Status = fwtypes.StringEnumValue(out.Status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenFormTypeImports(ctx context.Context, apiObjects []awstypes.Import) fwtypes.ListNestedObjectValueOf[importData] {
+	tfList := make([]*importData, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, &importData{
+			Name:     flex.StringToFramework(ctx, apiObject.Name),
+			Revision: flex.StringToFramework(ctx, apiObject.Revision),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfSliceMust(ctx, tfList)
+}
+
+func flattenFormTypeModel(ctx context.Context, apiObject awstypes.Model) fwtypes.ListNestedObjectValueOf[modelData] {
+	v, ok := apiObject.(*awstypes.ModelMemberSmithy)
+	if !ok {
+		return fwtypes.NewListNestedObjectValueOfNull[modelData](ctx)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &modelData{
+		Smithy: flex.StringValueToFramework(ctx, v.Value),
+	})
+}
+
+type dataSourceFormTypeModel struct {
+	CreatedAt          timetypes.RFC3339                           `tfsdk:"created_at"`
+	CreatedBy          types.String                                `tfsdk:"created_by"`
+	Description        types.String                                `tfsdk:"description"`
+	DomainIdentifier   types.String                                `tfsdk:"domain_identifier"`
+	FormTypeIdentifier types.String                                `tfsdk:"form_type_identifier"`
+	Imports            fwtypes.ListNestedObjectValueOf[importData] `tfsdk:"imports"`
+	Model              fwtypes.ListNestedObjectValueOf[modelData]  `tfsdk:"model"`
+	Name               types.String                                `tfsdk:"name"`
+	OriginDomainID     types.String                                `tfsdk:"origin_domain_id"`
+	OriginProjectID    types.String                                `tfsdk:"origin_project_id"`
+	OwningProjectID    types.String                                `tfsdk:"owning_project_id"`
+	Revision           types.String                                `tfsdk:"revision"`
+	Status             fwtypes.StringEnum[awstypes.FormTypeStatus] `tfsdk:"status"`
+}
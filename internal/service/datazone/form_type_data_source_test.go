@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneFormTypeDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_datazone_form_type.test"
+	latestDataSourceName := "data.aws_datazone_form_type.latest"
+	resourceName := "aws_datazone_form_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DataZoneEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFormTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFormTypeDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrCreatedAt, resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_by", resourceName, "created_by"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDescription, resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(dataSourceName, "model.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "model.0.smithy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "owning_project_id", resourceName, "owning_project_identifier"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "revision", resourceName, "revision"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrStatus, resourceName, names.AttrStatus),
+					resource.TestCheckResourceAttrPair(latestDataSourceName, "revision", resourceName, "revision"),
+					resource.TestCheckResourceAttrPair(latestDataSourceName, "owning_project_id", resourceName, "owning_project_identifier"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFormTypeDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFormTypeConfig_basic(rName), `
+data "aws_datazone_form_type" "test" {
+  domain_identifier    = aws_datazone_form_type.test.domain_identifier
+  form_type_identifier = aws_datazone_form_type.test.name
+  revision             = aws_datazone_form_type.test.revision
+}
+
+data "aws_datazone_form_type" "latest" {
+  domain_identifier    = aws_datazone_form_type.test.domain_identifier
+  form_type_identifier = aws_datazone_form_type.test.name
+
+  depends_on = [aws_datazone_form_type.test]
+}
+`)
+}
@@ -0,0 +1,565 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_datazone_glossary_terms", name="Glossary Terms")
+func newResourceGlossaryTerms(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceGlossaryTerms{}, nil
+}
+
+const (
+	ResNameGlossaryTerms = "Glossary Terms"
+
+	glossaryTermsIDParts = 2
+)
+
+type resourceGlossaryTerms struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceGlossaryTerms) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_glossary_terms"
+}
+
+func (r *resourceGlossaryTerms) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^dzd[-_][a-zA-Z0-9_-]{1,36}$`), "must conform to: ^dzd[-_][a-zA-Z0-9_-]{1,36}$ "),
+				},
+			},
+			"glossary_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9_-]{1,36}$`), "must conform to: ^[a-zA-Z0-9_-]{1,36}$"),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"term_ids": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"terms": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[glossaryTermsTermData](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"long_description": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 256),
+							},
+						},
+						"short_description": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrStatus: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.GlossaryTermStatus](),
+							Optional:   true,
+							Computed:   true,
+							Default:    fwtypes.StringEnumType[awstypes.GlossaryTermStatus]().AttributeDefault(awstypes.GlossaryTermStatusEnabled),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceGlossaryTerms) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resourceGlossaryTermsData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	terms, diags := data.Terms.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]struct{})
+	for _, v := range terms {
+		if v.Name.IsNull() || v.Name.IsUnknown() {
+			continue
+		}
+
+		name := v.Name.ValueString()
+		if _, ok := seen[name]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("terms"),
+				"Duplicate Glossary Term Name",
+				fmt.Sprintf("Glossary term names must be unique, %q is configured more than once.", name),
+			)
+		}
+		seen[name] = struct{}{}
+	}
+}
+
+func (r *resourceGlossaryTerms) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan resourceGlossaryTermsData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	terms, diags := plan.Terms.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainID, glossaryID := plan.DomainIdentifier.ValueString(), plan.GlossaryIdentifier.ValueString()
+	termIDs, createErr := createGlossaryTerms(ctx, conn, domainID, glossaryID, terms)
+
+	// Nothing was created, so there is nothing to track in state.
+	if createErr != nil && len(termIDs) == 0 {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameGlossaryTerms, glossaryID, createErr),
+			createErr.Error(),
+		)
+		return
+	}
+
+	id, err := intflex.FlattenResourceId([]string{domainID, glossaryID}, glossaryTermsIDParts, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionFlatteningResourceId, ResNameGlossaryTerms, glossaryID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+	plan.TermIDs = flattenGlossaryTermIDs(ctx, termIDs)
+	plan.Terms = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, filterGlossaryTermsByID(terms, termIDs))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	// The terms that were created are kept in state, so they remain managed, and the apply fails.
+	if createErr != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameGlossaryTerms, glossaryID, createErr),
+			createErr.Error(),
+		)
+	}
+}
+
+func (r *resourceGlossaryTerms) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceGlossaryTermsData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainID := state.DomainIdentifier.ValueString()
+	ids := expandGlossaryTermIDs(state.TermIDs)
+
+	// On import there are no term IDs in state, so all of the glossary's terms are read.
+	if state.TermIDs.IsNull() {
+		var err error
+		ids, err = findGlossaryTermIDsByGlossaryID(ctx, conn, domainID, state.GlossaryIdentifier.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionReading, ResNameGlossaryTerms, state.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	termIDs := make(map[string]string)
+	var terms []*glossaryTermsTermData
+
+	for _, id := range ids {
+		out, err := findGlossaryTermByID(ctx, conn, id, domainID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionReading, ResNameGlossaryTerms, state.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		termIDs[aws.ToString(out.Name)] = id
+		terms = append(terms, &glossaryTermsTermData{
+			LongDescription:  flex.StringToFramework(ctx, out.LongDescription),
+			Name:             flex.StringToFramework(ctx, out.Name),
+			ShortDescription: flex.StringToFramework(ctx, out.ShortDescription),
+			Status:           fwtypes.StringEnumValue(out.Status),
+		})
+	}
+
+	if len(termIDs) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.TermIDs = flattenGlossaryTermIDs(ctx, termIDs)
+	state.Terms = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, terms)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceGlossaryTerms) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan, state resourceGlossaryTermsData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planTerms, diags := plan.Terms.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	stateTerms, diags := state.Terms.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainID, glossaryID := plan.DomainIdentifier.ValueString(), plan.GlossaryIdentifier.ValueString()
+	stateIDs := expandGlossaryTermIDs(state.TermIDs)
+	planByName, stateByName := glossaryTermsByName(planTerms), glossaryTermsByName(stateTerms)
+
+	// Start from the prior state and apply each change that succeeds, so that a partial failure
+	// only leaves the failed terms to be planned again.
+	termIDs, terms := maps.Clone(stateIDs), maps.Clone(stateByName)
+	var errList []error
+
+	// Delete removed terms first so that a renamed term's new name is available.
+	for name, v := range stateByName {
+		if _, ok := planByName[name]; ok {
+			continue
+		}
+
+		if err := deleteGlossaryTerm(ctx, conn, domainID, stateIDs[name], v.Status.ValueEnum()); err != nil {
+			errList = append(errList, err)
+			continue
+		}
+
+		delete(termIDs, name)
+		delete(terms, name)
+	}
+
+	var add []*glossaryTermsTermData
+	for name, v := range planByName {
+		id, ok := stateIDs[name]
+		if !ok {
+			add = append(add, v)
+			continue
+		}
+
+		if old := stateByName[name]; glossaryTermsTermEqual(old, v) {
+			continue
+		}
+
+		if err := updateGlossaryTerm(ctx, conn, domainID, glossaryID, id, v); err != nil {
+			errList = append(errList, err)
+			continue
+		}
+
+		terms[name] = v
+	}
+
+	created, err := createGlossaryTerms(ctx, conn, domainID, glossaryID, add)
+	if err != nil {
+		errList = append(errList, err)
+	}
+	for name, id := range created {
+		termIDs[name] = id
+		terms[name] = planByName[name]
+	}
+
+	if err := errors.Join(errList...); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameGlossaryTerms, state.ID.String(), err),
+			err.Error(),
+		)
+	}
+
+	plan.ID = state.ID
+	plan.TermIDs = flattenGlossaryTermIDs(ctx, termIDs)
+	plan.Terms = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, slices.Collect(maps.Values(terms)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceGlossaryTerms) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceGlossaryTermsData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	terms, diags := state.Terms.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainID := state.DomainIdentifier.ValueString()
+	termIDs := expandGlossaryTermIDs(state.TermIDs)
+	var errList []error
+
+	for name, v := range glossaryTermsByName(terms) {
+		if err := deleteGlossaryTerm(ctx, conn, domainID, termIDs[name], v.Status.ValueEnum()); err != nil {
+			errList = append(errList, err)
+		}
+	}
+
+	if err := errors.Join(errList...); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameGlossaryTerms, state.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+func (r *resourceGlossaryTerms) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(req.ID, glossaryTermsIDParts, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: domain_identifier,glossary_identifier. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("glossary_identifier"), parts[1])...)
+}
+
+// findGlossaryTermIDsByGlossaryID returns the IDs of the glossary's terms, keyed by name.
+func findGlossaryTermIDsByGlossaryID(ctx context.Context, conn *datazone.Client, domainID, glossaryID string) (map[string]string, error) {
+	in := &datazone.SearchInput{
+		DomainIdentifier: aws.String(domainID),
+		SearchScope:      awstypes.InventorySearchScopeGlossaryTerm,
+	}
+	termIDs := make(map[string]string)
+
+	pages := datazone.NewSearchPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			if v, ok := v.(*awstypes.SearchInventoryResultItemMemberGlossaryTermItem); ok && aws.ToString(v.Value.GlossaryId) == glossaryID {
+				termIDs[aws.ToString(v.Value.Name)] = aws.ToString(v.Value.Id)
+			}
+		}
+	}
+
+	return termIDs, nil
+}
+
+// createGlossaryTerms creates each of the terms, continuing past failures.
+// It returns the IDs of the terms that were created, keyed by name, and the errors of those that were not.
+func createGlossaryTerms(ctx context.Context, conn *datazone.Client, domainID, glossaryID string, terms []*glossaryTermsTermData) (map[string]string, error) {
+	termIDs := make(map[string]string)
+	var errList []error
+
+	for _, v := range terms {
+		name := v.Name.ValueString()
+		in := &datazone.CreateGlossaryTermInput{
+			DomainIdentifier:   aws.String(domainID),
+			GlossaryIdentifier: aws.String(glossaryID),
+			LongDescription:    flex.StringFromFramework(ctx, v.LongDescription),
+			Name:               aws.String(name),
+			ShortDescription:   flex.StringFromFramework(ctx, v.ShortDescription),
+			Status:             v.Status.ValueEnum(),
+		}
+
+		out, err := conn.CreateGlossaryTerm(ctx, in)
+
+		if err != nil {
+			errList = append(errList, fmt.Errorf("creating DataZone Glossary Term (%s): %w", name, err))
+			continue
+		}
+
+		termIDs[name] = aws.ToString(out.Id)
+	}
+
+	return termIDs, errors.Join(errList...)
+}
+
+func updateGlossaryTerm(ctx context.Context, conn *datazone.Client, domainID, glossaryID, id string, term *glossaryTermsTermData) error {
+	in := &datazone.UpdateGlossaryTermInput{
+		DomainIdentifier:   aws.String(domainID),
+		GlossaryIdentifier: aws.String(glossaryID),
+		Identifier:         aws.String(id),
+		LongDescription:    flex.StringFromFramework(ctx, term.LongDescription),
+		Name:               flex.StringFromFramework(ctx, term.Name),
+		ShortDescription:   flex.StringFromFramework(ctx, term.ShortDescription),
+		Status:             term.Status.ValueEnum(),
+	}
+
+	if _, err := conn.UpdateGlossaryTerm(ctx, in); err != nil {
+		return fmt.Errorf("updating DataZone Glossary Term (%s): %w", term.Name.ValueString(), err)
+	}
+
+	return nil
+}
+
+// deleteGlossaryTerm disables an enabled term, which DataZone requires before deletion, and then deletes it.
+func deleteGlossaryTerm(ctx context.Context, conn *datazone.Client, domainID, id string, status awstypes.GlossaryTermStatus) error {
+	if status == awstypes.GlossaryTermStatusEnabled {
+		in := &datazone.UpdateGlossaryTermInput{
+			DomainIdentifier: aws.String(domainID),
+			Identifier:       aws.String(id),
+			Status:           awstypes.GlossaryTermStatusDisabled,
+		}
+
+		_, err := conn.UpdateGlossaryTerm(ctx, in)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("disabling DataZone Glossary Term (%s): %w", id, err)
+		}
+	}
+
+	in := &datazone.DeleteGlossaryTermInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(id),
+	}
+
+	_, err := conn.DeleteGlossaryTerm(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting DataZone Glossary Term (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+func glossaryTermsByName(terms []*glossaryTermsTermData) map[string]*glossaryTermsTermData {
+	m := make(map[string]*glossaryTermsTermData, len(terms))
+
+	for _, v := range terms {
+		m[v.Name.ValueString()] = v
+	}
+
+	return m
+}
+
+func glossaryTermsTermEqual(a, b *glossaryTermsTermData) bool {
+	return a.LongDescription.Equal(b.LongDescription) && a.Name.Equal(b.Name) && a.ShortDescription.Equal(b.ShortDescription) && a.Status.Equal(b.Status)
+}
+
+func filterGlossaryTermsByID(terms []*glossaryTermsTermData, termIDs map[string]string) []*glossaryTermsTermData {
+	return slices.DeleteFunc(slices.Clone(terms), func(v *glossaryTermsTermData) bool {
+		_, ok := termIDs[v.Name.ValueString()]
+		return !ok
+	})
+}
+
+func expandGlossaryTermIDs(tfMap fwtypes.MapValueOf[types.String]) map[string]string {
+	termIDs := make(map[string]string)
+
+	for k, v := range tfMap.Elements() {
+		if v, ok := v.(types.String); ok {
+			termIDs[k] = v.ValueString()
+		}
+	}
+
+	return termIDs
+}
+
+func flattenGlossaryTermIDs(ctx context.Context, termIDs map[string]string) fwtypes.MapValueOf[types.String] {
+	elements := make(map[string]attr.Value, len(termIDs))
+
+	for k, v := range termIDs {
+		elements[k] = types.StringValue(v)
+	}
+
+	return fwtypes.NewMapValueOfMust[types.String](ctx, elements)
+}
+
+type resourceGlossaryTermsData struct {
+	DomainIdentifier   types.String                                          `tfsdk:"domain_identifier"`
+	GlossaryIdentifier types.String                                          `tfsdk:"glossary_identifier"`
+	ID                 types.String                                          `tfsdk:"id"`
+	TermIDs            fwtypes.MapValueOf[types.String]                      `tfsdk:"term_ids"`
+	Terms              fwtypes.SetNestedObjectValueOf[glossaryTermsTermData] `tfsdk:"terms"`
+}
+
+type glossaryTermsTermData struct {
+	LongDescription  types.String                                    `tfsdk:"long_description"`
+	Name             types.String                                    `tfsdk:"name"`
+	ShortDescription types.String                                    `tfsdk:"short_description"`
+	Status           fwtypes.StringEnum[awstypes.GlossaryTermStatus] `tfsdk:"status"`
+}
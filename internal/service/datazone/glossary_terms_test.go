@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	"github.com/aws/smithy-go/middleware"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneGlossaryTerms_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName3 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	gName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	pName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resourceName := "aws_datazone_glossary_terms.test"
+	glossaryName := "aws_datazone_glossary.test"
+	domainName := "aws_datazone_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DataZoneEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlossaryTermsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlossaryTermsConfig_basic(rName1, rName2, gName, dName, pName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlossaryTermsExist(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_identifier", domainName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "glossary_identifier", glossaryName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "terms.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "terms.*", map[string]string{
+						names.AttrName:      rName1,
+						"short_description": "short_desc",
+						names.AttrStatus:    "ENABLED",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "terms.*", map[string]string{
+						names.AttrName:   rName2,
+						names.AttrStatus: "DISABLED",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "term_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("term_ids.%s", rName1)),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("term_ids.%s", rName2)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlossaryTermsConfig_update(rName2, rName3, gName, dName, pName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlossaryTermsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "terms.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "terms.*", map[string]string{
+						names.AttrName:      rName2,
+						"short_description": "short",
+						names.AttrStatus:    "ENABLED",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "terms.*", map[string]string{
+						names.AttrName:   rName3,
+						names.AttrStatus: "ENABLED",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "term_ids.%", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, fmt.Sprintf("term_ids.%s", rName1)),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("term_ids.%s", rName3)),
+				),
+			},
+		},
+	})
+}
+
+func TestCreateGlossaryTermsPartialFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := datazone.New(datazone.Options{
+		Region: "us-west-2", //lintignore:AWSAT003
+		APIOptions: []func(*middleware.Stack) error{
+			acctest.StubAPIResponses(func(in any) (any, error) {
+				input, ok := in.(*datazone.CreateGlossaryTermInput)
+				if !ok {
+					return nil, fmt.Errorf("unexpected input type %T", in)
+				}
+
+				name := aws.ToString(input.Name)
+				if strings.HasPrefix(name, "bad") {
+					return nil, errors.New("conflict")
+				}
+
+				return &datazone.CreateGlossaryTermOutput{Id: aws.String("id-" + name)}, nil
+			}),
+		},
+	})
+
+	termIDs, err := tfdatazone.CreateGlossaryTerms(ctx, conn, "dzd_test", "glossary", "good1", "bad1", "good2", "bad2")
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	for _, name := range []string{"bad1", "bad2"} {
+		if want := fmt.Sprintf("creating DataZone Glossary Term (%s)", name); !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not contain %q", err, want)
+		}
+	}
+
+	if got, want := len(termIDs), 2; got != want {
+		t.Fatalf("created terms = %d, want %d", got, want)
+	}
+
+	for _, name := range []string{"good1", "good2"} {
+		if got, want := termIDs[name], "id-"+name; got != want {
+			t.Errorf("term %s ID = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func testAccCheckGlossaryTermsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_glossary_terms" {
+				continue
+			}
+
+			for _, id := range glossaryTermsIDsFromState(rs) {
+				_, err := tfdatazone.FindGlossaryTermByID(ctx, conn, id, rs.Primary.Attributes["domain_identifier"])
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameGlossaryTerms, id, err)
+				}
+
+				return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameGlossaryTerms, id, errors.New("not destroyed"))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGlossaryTermsExist(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameGlossaryTerms, name, errors.New("not found"))
+		}
+
+		ids := glossaryTermsIDsFromState(rs)
+		if len(ids) == 0 {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameGlossaryTerms, name, errors.New("no term IDs set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, id := range ids {
+			if _, err := tfdatazone.FindGlossaryTermByID(ctx, conn, id, rs.Primary.Attributes["domain_identifier"]); err != nil {
+				return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameGlossaryTerms, id, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func glossaryTermsIDsFromState(rs *terraform.ResourceState) []string {
+	var ids []string
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "term_ids.") && k != "term_ids.%" {
+			ids = append(ids, v)
+		}
+	}
+
+	return ids
+}
+
+func testAccGlossaryTermsConfig_basic(rName1, rName2, gName, dName, pName string) string {
+	return acctest.ConfigCompose(testAccGlossaryConfig_basic(gName, "", dName, pName), fmt.Sprintf(`
+resource "aws_datazone_glossary_terms" "test" {
+  domain_identifier   = aws_datazone_domain.test.id
+  glossary_identifier = aws_datazone_glossary.test.id
+
+  terms {
+    long_description  = "long_description"
+    name              = %[1]q
+    short_description = "short_desc"
+  }
+
+  terms {
+    name   = %[2]q
+    status = "DISABLED"
+  }
+}
+`, rName1, rName2))
+}
+
+func testAccGlossaryTermsConfig_update(rName2, rName3, gName, dName, pName string) string {
+	return acctest.ConfigCompose(testAccGlossaryConfig_basic(gName, "", dName, pName), fmt.Sprintf(`
+resource "aws_datazone_glossary_terms" "test" {
+  domain_identifier   = aws_datazone_domain.test.id
+  glossary_identifier = aws_datazone_glossary.test.id
+
+  terms {
+    name              = %[1]q
+    short_description = "short"
+  }
+
+  terms {
+    name = %[2]q
+  }
+}
+`, rName2, rName3))
+}
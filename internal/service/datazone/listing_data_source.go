@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Listing")
+func newDataSourceListing(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceListing{}, nil
+}
+
+const (
+	DSNameListing = "Listing Data Source"
+)
+
+type dataSourceListing struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceListing) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_datazone_listing"
+}
+
+func (d *dataSourceListing) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrIdentifier: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"item": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSourceListingItemModel](ctx),
+				Computed:   true,
+			},
+			"listing_revision": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			"search_text": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ListingStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSourceListingFilterModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"attribute": schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceListing) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot(names.AttrIdentifier),
+			path.MatchRoot("search_text"),
+			path.MatchRoot(names.AttrFilter),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot(names.AttrIdentifier),
+			path.MatchRoot("search_text"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot(names.AttrIdentifier),
+			path.MatchRoot(names.AttrFilter),
+		),
+	}
+}
+
+func (d *dataSourceListing) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().DataZoneClient(ctx)
+
+	var data dataSourceListingModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainID := data.DomainIdentifier.ValueString()
+	listingID, listingRevision := data.Identifier.ValueString(), data.ListingRevision.ValueString()
+
+	if listingID == "" {
+		in := &datazone.SearchListingsInput{
+			DomainIdentifier: aws.String(domainID),
+			SearchText:       flex.StringFromFramework(ctx, data.SearchText),
+		}
+
+		filters, diags := data.Filters.ToSlice(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		in.Filters = expandListingFilters(filters)
+
+		item, err := findListingSearchResultItem(ctx, conn, in)
+		if err != nil {
+			err = tfresource.SingularDataSourceFindError("DataZone Listing", err)
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionReading, DSNameListing, data.SearchText.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		var revision string
+		listingID, revision = listingSearchResultItemID(*item)
+		if listingRevision == "" {
+			listingRevision = revision
+		}
+	}
+
+	out, err := findListingByID(ctx, conn, domainID, listingID, listingRevision)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionReading, DSNameListing, listingID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Description = flex.StringToFramework(ctx, out.Description)
+	data.ID = flex.StringToFramework(ctx, out.Id)
+	data.Identifier = flex.StringToFramework(ctx, out.Id)
+	data.Item = flattenListingItem(ctx, out.Item)
+	data.ListingRevision = flex.StringToFramework(ctx, out.ListingRevision)
+	data.Name = flex.StringToFramework(ctx, out.Name)
+	data.Status = fwtypes.StringEnumValue(out.Status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findListingByID(ctx context.Context, conn *datazone.Client, domainID, id, revision string) (*datazone.GetListingOutput, error) {
+	in := &datazone.GetListingInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(id),
+	}
+	if revision != "" {
+		in.ListingRevision = aws.String(revision)
+	}
+
+	out, err := conn.GetListing(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func findListingSearchResultItem(ctx context.Context, conn *datazone.Client, in *datazone.SearchListingsInput) (*awstypes.SearchResultItem, error) {
+	output, err := findListingSearchResultItems(ctx, conn, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+// findListingSearchResultItems returns the asset and data product listings that match the search.
+func findListingSearchResultItems(ctx context.Context, conn *datazone.Client, in *datazone.SearchListingsInput) ([]awstypes.SearchResultItem, error) {
+	var output []awstypes.SearchResultItem
+
+	pages := datazone.NewSearchListingsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			if id, _ := listingSearchResultItemID(v); id != "" {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// listingSearchResultItemID returns the listing ID and revision of an asset or data product listing search result.
+func listingSearchResultItemID(apiObject awstypes.SearchResultItem) (string, string) {
+	switch v := apiObject.(type) {
+	case *awstypes.SearchResultItemMemberAssetListing:
+		return aws.ToString(v.Value.ListingId), aws.ToString(v.Value.ListingRevision)
+	case *awstypes.SearchResultItemMemberDataProductListing:
+		return aws.ToString(v.Value.ListingId), aws.ToString(v.Value.ListingRevision)
+	default:
+		return "", ""
+	}
+}
+
+func expandListingFilters(tfList []*dataSourceListingFilterModel) awstypes.FilterClause {
+	var apiObjects []awstypes.FilterClause
+
+	for _, tfObj := range tfList {
+		apiObjects = append(apiObjects, &awstypes.FilterClauseMemberFilter{
+			Value: awstypes.Filter{
+				Attribute: tfObj.Attribute.ValueStringPointer(),
+				Value:     tfObj.Value.ValueStringPointer(),
+			},
+		})
+	}
+
+	switch len(apiObjects) {
+	case 0:
+		return nil
+	case 1:
+		return apiObjects[0]
+	default:
+		return &awstypes.FilterClauseMemberAnd{Value: apiObjects}
+	}
+}
+
+func flattenListingItem(ctx context.Context, apiObject awstypes.ListingItem) fwtypes.ListNestedObjectValueOf[dataSourceListingItemModel] {
+	var tfObj dataSourceListingItemModel
+
+	switch v := apiObject.(type) {
+	case *awstypes.ListingItemMemberAssetListing:
+		tfObj = dataSourceListingItemModel{
+			AssetID:         flex.StringToFramework(ctx, v.Value.AssetId),
+			AssetRevision:   flex.StringToFramework(ctx, v.Value.AssetRevision),
+			AssetType:       flex.StringToFramework(ctx, v.Value.AssetType),
+			Forms:           flex.StringToFramework(ctx, v.Value.Forms),
+			OwningProjectID: flex.StringToFramework(ctx, v.Value.OwningProjectId),
+		}
+	case *awstypes.ListingItemMemberDataProductListing:
+		tfObj = dataSourceListingItemModel{
+			DataProductID:       flex.StringToFramework(ctx, v.Value.DataProductId),
+			DataProductRevision: flex.StringToFramework(ctx, v.Value.DataProductRevision),
+			Forms:               flex.StringToFramework(ctx, v.Value.Forms),
+			OwningProjectID:     flex.StringToFramework(ctx, v.Value.OwningProjectId),
+		}
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[dataSourceListingItemModel](ctx)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObj)
+}
+
+type dataSourceListingModel struct {
+	Description      types.String                                                  `tfsdk:"description"`
+	DomainIdentifier types.String                                                  `tfsdk:"domain_identifier"`
+	Filters          fwtypes.ListNestedObjectValueOf[dataSourceListingFilterModel] `tfsdk:"filter"`
+	ID               types.String                                                  `tfsdk:"id"`
+	Identifier       types.String                                                  `tfsdk:"identifier"`
+	Item             fwtypes.ListNestedObjectValueOf[dataSourceListingItemModel]   `tfsdk:"item"`
+	ListingRevision  types.String                                                  `tfsdk:"listing_revision"`
+	Name             types.String                                                  `tfsdk:"name"`
+	SearchText       types.String                                                  `tfsdk:"search_text"`
+	Status           fwtypes.StringEnum[awstypes.ListingStatus]                    `tfsdk:"status"`
+}
+
+type dataSourceListingFilterModel struct {
+	Attribute types.String `tfsdk:"attribute"`
+	Value     types.String `tfsdk:"value"`
+}
+
+type dataSourceListingItemModel struct {
+	AssetID             types.String `tfsdk:"asset_id"`
+	AssetRevision       types.String `tfsdk:"asset_revision"`
+	AssetType           types.String `tfsdk:"asset_type"`
+	DataProductID       types.String `tfsdk:"data_product_id"`
+	DataProductRevision types.String `tfsdk:"data_product_revision"`
+	Forms               types.String `tfsdk:"forms"`
+	OwningProjectID     types.String `tfsdk:"owning_project_id"`
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneListingDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	pName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_datazone_listing.test"
+	projectName := "aws_datazone_project.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetTypeConfig_basic(rName, pName, dName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListingAssetPublished(ctx, "aws_datazone_asset_type.test", rName),
+				),
+			},
+			{
+				Config: testAccListingDataSourceConfig_basic(rName, pName, dName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrIdentifier),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, dataSourceName, names.AttrIdentifier),
+					resource.TestCheckResourceAttr(dataSourceName, "item.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "item.0.asset_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "item.0.owning_project_id", projectName, names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "listing_revision"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, string(awstypes.ListingStatusActive)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneListingDataSource_noMatch(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccListingDataSourceConfig_searchText(rName),
+				ExpectError: regexache.MustCompile(`no matching DataZone Listing found`),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneListingDataSource_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccListingDataSourceConfig_noCriteria(),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccListingDataSourceConfig_conflicting(),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+// testAccCheckListingAssetPublished creates an asset of the asset type and publishes it to the catalog,
+// as there are no resources for assets or their listings.
+func testAccCheckListingAssetPublished(ctx context.Context, n, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+		domainID := rs.Primary.Attributes["domain_identifier"]
+
+		asset, err := conn.CreateAsset(ctx, &datazone.CreateAssetInput{
+			DomainIdentifier:        aws.String(domainID),
+			Name:                    aws.String(name),
+			OwningProjectIdentifier: aws.String(rs.Primary.Attributes["owning_project_identifier"]),
+			TypeIdentifier:          aws.String(rs.Primary.Attributes[names.AttrName]),
+		})
+
+		if err != nil {
+			return fmt.Errorf("creating DataZone Asset (%s): %w", name, err)
+		}
+
+		changeSet, err := conn.CreateListingChangeSet(ctx, &datazone.CreateListingChangeSetInput{
+			Action:           awstypes.ChangeActionPublish,
+			DomainIdentifier: aws.String(domainID),
+			EntityIdentifier: asset.Id,
+			EntityType:       awstypes.EntityTypeAsset,
+		})
+
+		if err != nil {
+			return fmt.Errorf("publishing DataZone Asset (%s): %w", name, err)
+		}
+
+		_, err = tfresource.RetryUntilEqual(ctx, 5*time.Minute, awstypes.ListingStatusActive, func() (awstypes.ListingStatus, error) {
+			listing, err := tfdatazone.FindListingByID(ctx, conn, domainID, aws.ToString(changeSet.ListingId), "")
+
+			if err != nil {
+				return "", err
+			}
+
+			return listing.Status, nil
+		})
+
+		return err
+	}
+}
+
+func testAccListingDataSourceConfig_basic(rName, pName, dName string) string {
+	return acctest.ConfigCompose(
+		testAccAssetTypeConfig_basic(rName, pName, dName),
+		fmt.Sprintf(`
+data "aws_datazone_listing" "test" {
+  domain_identifier = aws_datazone_domain.test.id
+  search_text       = %[1]q
+
+  filter {
+    attribute = "amazonmetadata.owningProjectId"
+    value     = aws_datazone_project.test.id
+  }
+}
+`, rName),
+	)
+}
+
+func testAccListingDataSourceConfig_searchText(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDomainConfig_basic(rName),
+		fmt.Sprintf(`
+data "aws_datazone_listing" "test" {
+  domain_identifier = aws_datazone_domain.test.id
+  search_text       = %[1]q
+}
+`, rName),
+	)
+}
+
+func testAccListingDataSourceConfig_noCriteria() string {
+	return `
+data "aws_datazone_listing" "test" {
+  domain_identifier = "dzd_0000000000000"
+}
+`
+}
+
+func testAccListingDataSourceConfig_conflicting() string {
+	return `
+data "aws_datazone_listing" "test" {
+  domain_identifier = "dzd_0000000000000"
+  identifier        = "0000000000000"
+  search_text       = "example"
+}
+`
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -40,13 +42,17 @@ import (
 // @FrameworkResource("aws_datazone_project", name="Project")
 func newResourceProject(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceProject{}
-	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultCreateTimeout(30 * time.Minute)
 	r.SetDefaultDeleteTimeout(10 * time.Minute)
 	return r, nil
 }
 
 const (
 	ResNameProject = "Project"
+
+	// projectThrottlingTimeout bounds how long a single project API call is retried
+	// on throttling or transient errors once the SDK's standard retryer has given up.
+	projectThrottlingTimeout = 5 * time.Minute
 )
 
 type resourceProject struct {
@@ -76,6 +82,18 @@ func (r *resourceProject) Schema(ctx context.Context, req resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"domain_unit_identifier": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-z0-9_\-]+$`), "must conform to: ^[a-z0-9_\\-]+$ "),
+					stringvalidator.LengthBetween(1, 256),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 
 			"glossary_terms": schema.ListAttribute{
 				CustomType:  fwtypes.ListOfStringType,
@@ -116,6 +134,9 @@ func (r *resourceProject) Schema(ctx context.Context, req resource.SchemaRequest
 			"last_updated_at": schema.StringAttribute{
 				CustomType: timetypes.RFC3339Type{},
 				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"project_status": schema.StringAttribute{
 				CustomType: fwtypes.StringEnumType[awstypes.ProjectStatus](),
@@ -148,17 +169,42 @@ func (r *resourceProject) Create(ctx context.Context, req resource.CreateRequest
 	}
 	conn := r.Meta().DataZoneClient(ctx)
 
-	in := &datazone.CreateProjectInput{}
-	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	domainID := plan.DomainIdentifier.ValueString()
+	domain, err := findDomainByID(ctx, conn, domainID)
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameProject, plan.Name.String(), err),
+			fmt.Sprintf("DataZone Domain (%s) not found in the configured region and account. Check the provider region and any DataZone endpoint override.", domainID),
+		)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameProject, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
 
+	resp.Diagnostics.Append(checkDomainAvailable(domainID, domain.Status)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	out, err := conn.CreateProject(ctx, in)
+	in := &datazone.CreateProjectInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// When omitted, the project is created in the domain's root domain unit.
+	in.DomainUnitId = flex.StringFromFramework(ctx, plan.DomainUnitIdentifier)
+
+	// CreateProject takes no client token, so unlike the other project calls it isn't retried
+	// on throttling or transient errors, as a request that succeeded server-side would create a duplicate project.
+	out, err := conn.CreateProject(ctx, in)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -184,12 +230,10 @@ func (r *resourceProject) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	resp.Diagnostics.Append(flex.Flatten(ctx, out, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	// The project may not be readable, or may be returned partially, until it is active,
+	// so state is set from the project as read once the waiter completes.
 	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
-	_, err = waitProjectCreated(ctx, conn, plan.DomainIdentifier.ValueString(), plan.ID.ValueString(), createTimeout)
+	project, err := waitProjectCreated(ctx, conn, plan.DomainIdentifier.ValueString(), aws.ToString(out.Id), createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForCreation, ResNameProject, plan.Name.String(), err),
@@ -198,6 +242,14 @@ func (r *resourceProject) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	description := plan.Description
+	resp.Diagnostics.Append(flex.Flatten(ctx, project, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Description = normalizeProjectDescription(description, plan.Description)
+	plan.DomainUnitIdentifier = flex.StringToFramework(ctx, project.DomainUnitId)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -222,10 +274,24 @@ func (r *resourceProject) Read(ctx context.Context, req resource.ReadRequest, re
 		)
 		return
 	}
+	description := state.Description
 	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.Description = normalizeProjectDescription(description, state.Description)
+	state.DomainUnitIdentifier = flex.StringToFramework(ctx, out.DomainUnitId)
+
+	resp.Diagnostics.Append(checkProjectStatus(state.ID.ValueString(), out.ProjectStatus)...)
+
+	// A project that failed to create, update or delete is kept in state so that
+	// its status and failure reasons are visible.
+	for _, v := range out.FailureReasons {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("DataZone Project (%s) is in status %s", state.ID.ValueString(), out.ProjectStatus),
+			fmt.Sprintf("error code: %s, error message: %s", aws.ToString(v.Code), aws.ToString(v.Message)),
+		)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -239,7 +305,7 @@ func (r *resourceProject) Update(ctx context.Context, req resource.UpdateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if !plan.Description.Equal(state.Description) || !plan.GlossaryTerms.Equal(state.GlossaryTerms) || !plan.Name.Equal(state.Name) {
+	if projectHasChanges(plan, state) {
 		in := &datazone.UpdateProjectInput{}
 		resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
 
@@ -247,7 +313,13 @@ func (r *resourceProject) Update(ctx context.Context, req resource.UpdateRequest
 			return
 		}
 		in.Identifier = plan.ID.ValueStringPointer()
-		out, err := conn.UpdateProject(ctx, in)
+		// A nil description leaves the current description in place.
+		if plan.Description.IsNull() && !state.Description.IsNull() {
+			in.Description = aws.String("")
+		}
+		out, err := retryProjectWhenThrottled(ctx, projectThrottlingTimeout, func() (*datazone.UpdateProjectOutput, error) {
+			return conn.UpdateProject(ctx, in)
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameProject, plan.ID.String(), err),
@@ -267,6 +339,7 @@ func (r *resourceProject) Update(ctx context.Context, req resource.UpdateRequest
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		state.Description = normalizeProjectDescription(plan.Description, state.Description)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -289,11 +362,22 @@ func (r *resourceProject) Delete(ctx context.Context, req resource.DeleteRequest
 		in.SkipDeletionCheck = state.SkipDeletionCheck.ValueBoolPointer()
 	}
 
-	_, err := conn.DeleteProject(ctx, in)
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err := retryProjectWhenThrottled(ctx, deleteTimeout, func() (*datazone.DeleteProjectOutput, error) {
+		return conn.DeleteProject(ctx, in)
+	})
 	if err != nil {
 		if errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsA[*awstypes.AccessDeniedException](err) {
 			return
 		}
+		if !state.SkipDeletionCheck.ValueBool() && isProjectHasChildResourcesError(err) {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameProject, state.ID.String(), err),
+				fmt.Sprintf("DataZone Project (%s) still contains child resources, such as assets, environments or data sources. "+
+					"Delete them first, or set skip_deletion_check = true and apply that change before destroying the project to delete them along with it.\n\n%s", state.ID.ValueString(), err),
+			)
+			return
+		}
 		resp.Diagnostics.AddError(
 			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameProject, state.ID.String(), err),
 			err.Error(),
@@ -301,7 +385,6 @@ func (r *resourceProject) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
 	_, err = waitProjectDeleted(ctx, conn, state.DomainIdentifier.ValueString(), state.ID.ValueString(), deleteTimeout)
 
 	if err != nil && !errs.IsA[*awstypes.AccessDeniedException](err) {
@@ -313,6 +396,24 @@ func (r *resourceProject) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+func (r *resourceProject) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state resourceProjectData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// last_updated_at keeps its prior value unless the project is actually updated.
+	if projectHasChanges(plan, state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_updated_at"), timetypes.NewRFC3339Unknown())...)
+	}
+}
+
 func (r *resourceProject) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.Split(req.ID, ":")
 
@@ -326,17 +427,22 @@ func (r *resourceProject) ImportState(ctx context.Context, req resource.ImportSt
 }
 
 func waitProjectCreated(ctx context.Context, conn *datazone.Client, domain string, identifier string, timeout time.Duration) (*datazone.GetProjectOutput, error) {
+	// A new project can be read as ACTIVE and then again as not found or without a status,
+	// so a fixed run of ACTIVE reads is required before its state is hydrated from the last one.
 	stateConf := &retry.StateChangeConf{
 		Pending:                   []string{},
 		Target:                    enum.Slice(awstypes.ProjectStatusActive),
 		Refresh:                   statusProject(ctx, conn, domain, identifier),
 		Timeout:                   timeout,
-		NotFoundChecks:            40,
+		PollInterval:              waiterPollInterval(timeout),
+		NotFoundChecks:            waiterNotFoundChecks(timeout),
 		ContinuousTargetOccurence: 10,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*datazone.GetProjectOutput); ok {
+		tfresource.SetLastError(err, projectFailureReasonsError(out.FailureReasons))
+
 		return out, err
 	}
 
@@ -353,12 +459,24 @@ func waitProjectDeleted(ctx context.Context, conn *datazone.Client, domain strin
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*datazone.GetProjectOutput); ok {
+		tfresource.SetLastError(err, projectFailureReasonsError(out.FailureReasons))
+
 		return out, err
 	}
 
 	return nil, err
 }
 
+func projectFailureReasonsError(apiObjects []awstypes.ProjectDeletionError) error {
+	var failures []error
+
+	for _, v := range apiObjects {
+		failures = append(failures, fmt.Errorf("%s: %s", aws.ToString(v.Code), aws.ToString(v.Message)))
+	}
+
+	return errors.Join(failures...)
+}
+
 func statusProject(ctx context.Context, conn *datazone.Client, domain string, identifier string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		out, err := findProjectByID(ctx, conn, domain, identifier)
@@ -370,7 +488,12 @@ func statusProject(ctx context.Context, conn *datazone.Client, domain string, id
 			return nil, "", err
 		}
 
-		return out, aws.ToString((*string)(&out.ProjectStatus)), nil
+		// Immediately after creation the project may be returned without its status.
+		if out.ProjectStatus == "" {
+			return nil, "", nil
+		}
+
+		return out, string(out.ProjectStatus), nil
 	}
 }
 
@@ -379,7 +502,9 @@ func findProjectByID(ctx context.Context, conn *datazone.Client, domain string,
 		DomainIdentifier: aws.String(domain),
 		Identifier:       aws.String(identifier),
 	}
-	out, err := conn.GetProject(ctx, in)
+	out, err := retryProjectWhenThrottled(ctx, projectThrottlingTimeout, func() (*datazone.GetProjectOutput, error) {
+		return conn.GetProject(ctx, in)
+	})
 	if err != nil {
 		if errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsA[*awstypes.AccessDeniedException](err) {
 			return nil, &retry.NotFoundError{
@@ -390,26 +515,103 @@ func findProjectByID(ctx context.Context, conn *datazone.Client, domain string,
 		return nil, err
 	}
 
-	if out == nil || !(out.FailureReasons == nil) {
+	if out == nil {
 		return nil, tfresource.NewEmptyResultError(in)
 	}
 
 	return out, nil
 }
 
+// retryProjectWhenThrottled retries f with exponential backoff while it returns
+// a throttling or transient server-side error. Only idempotent calls may be retried this way.
+func retryProjectWhenThrottled[T any](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
+	return tfresource.RetryGWhen(ctx, timeout, f, func(err error) (bool, error) {
+		if isThrottlingOrTransientError(err) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+// normalizeProjectDescription returns the prior description if it differs from the API's
+// description only in line endings or trailing whitespace, otherwise the API's description
+// with that normalization applied. An empty description is reported as null when no prior
+// description is set, as that is how a cleared description is represented in state.
+func normalizeProjectDescription(prior, current types.String) types.String {
+	if current.IsNull() || current.IsUnknown() {
+		return current
+	}
+
+	normalized := normalizeDescription(current.ValueString())
+	if normalized == "" && prior.IsNull() {
+		return prior
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && normalizeDescription(prior.ValueString()) == normalized {
+		return prior
+	}
+
+	return types.StringValue(normalized)
+}
+
+// projectHasChanges returns whether the planned project differs from its state in any attribute that is sent to UpdateProject.
+func projectHasChanges(plan, state resourceProjectData) bool {
+	return !plan.Description.Equal(state.Description) || !plan.GlossaryTerms.Equal(state.GlossaryTerms) || !plan.Name.Equal(state.Name)
+}
+
+// checkProjectStatus returns a warning if the status is not a known ProjectStatus value.
+// Flattening keeps such a value as-is, so an imported project does not fail to read
+// when DataZone reports a status this provider does not know about yet.
+func checkProjectStatus(id string, status awstypes.ProjectStatus) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if status == "" || slices.Contains(status.Values(), status) {
+		return diags
+	}
+
+	diags.AddWarning(
+		fmt.Sprintf("DataZone Project (%s) has an unrecognized status", id),
+		fmt.Sprintf("project_status %q is not one of %q and is stored as reported by DataZone.", status, enum.Values[awstypes.ProjectStatus]()),
+	)
+
+	return diags
+}
+
+// checkDomainAvailable returns an error if the domain a project is created in is not AVAILABLE.
+// CreateProject fails with an unhelpful error while the domain is still being created or is being deleted.
+func checkDomainAvailable(id string, status awstypes.DomainStatus) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if status == awstypes.DomainStatusAvailable {
+		return diags
+	}
+
+	diags.AddError(
+		fmt.Sprintf("DataZone Domain (%s) is not available", id),
+		fmt.Sprintf("Projects can only be created in a domain with status %q, but the domain's current status is %q.", awstypes.DomainStatusAvailable, status),
+	)
+
+	return diags
+}
+
+func normalizeDescription(s string) string {
+	return strings.TrimRight(strings.ReplaceAll(s, "\r\n", "\n"), " \t\r\n")
+}
+
 type resourceProjectData struct {
-	Description       types.String                                            `tfsdk:"description"`
-	DomainIdentifier  types.String                                            `tfsdk:"domain_identifier"`
-	Name              types.String                                            `tfsdk:"name"`
-	CreatedBy         types.String                                            `tfsdk:"created_by"`
-	ID                types.String                                            `tfsdk:"id"`
-	CreatedAt         timetypes.RFC3339                                       `tfsdk:"created_at"`
-	FailureReasons    fwtypes.ListNestedObjectValueOf[dsProjectDeletionError] `tfsdk:"failure_reasons"`
-	LastUpdatedAt     timetypes.RFC3339                                       `tfsdk:"last_updated_at"`
-	ProjectStatus     fwtypes.StringEnum[awstypes.ProjectStatus]              `tfsdk:"project_status"`
-	Timeouts          timeouts.Value                                          `tfsdk:"timeouts"`
-	SkipDeletionCheck types.Bool                                              `tfsdk:"skip_deletion_check"`
-	GlossaryTerms     fwtypes.ListValueOf[types.String]                       `tfsdk:"glossary_terms"`
+	Description          types.String                                            `tfsdk:"description"`
+	DomainIdentifier     types.String                                            `tfsdk:"domain_identifier"`
+	DomainUnitIdentifier types.String                                            `tfsdk:"domain_unit_identifier"`
+	Name                 types.String                                            `tfsdk:"name"`
+	CreatedBy            types.String                                            `tfsdk:"created_by"`
+	ID                   types.String                                            `tfsdk:"id"`
+	CreatedAt            timetypes.RFC3339                                       `tfsdk:"created_at"`
+	FailureReasons       fwtypes.ListNestedObjectValueOf[dsProjectDeletionError] `tfsdk:"failure_reasons"`
+	LastUpdatedAt        timetypes.RFC3339                                       `tfsdk:"last_updated_at"`
+	ProjectStatus        fwtypes.StringEnum[awstypes.ProjectStatus]              `tfsdk:"project_status"`
+	Timeouts             timeouts.Value                                          `tfsdk:"timeouts"`
+	SkipDeletionCheck    types.Bool                                              `tfsdk:"skip_deletion_check"`
+	GlossaryTerms        fwtypes.ListValueOf[types.String]                       `tfsdk:"glossary_terms"`
 }
 
 type dsProjectDeletionError struct {
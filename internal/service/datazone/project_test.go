@@ -7,18 +7,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/datazone"
 	"github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -48,6 +57,7 @@ func TestAccDataZoneProject_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckProjectExists(ctx, resourceName, &project),
 					resource.TestCheckResourceAttrPair(resourceName, "domain_identifier", domainName, names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "domain_unit_identifier"),
 					resource.TestCheckResourceAttrSet(resourceName, "glossary_terms.#"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "desc"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
@@ -55,6 +65,8 @@ func TestAccDataZoneProject_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
 					resource.TestCheckResourceAttrSet(resourceName, "last_updated_at"),
+					resource.TestCheckResourceAttr(resourceName, "failure_reasons.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "project_status", string(types.ProjectStatusActive)),
 				),
 			},
 			{
@@ -95,6 +107,275 @@ func TestAccDataZoneProject_disappears(t *testing.T) {
 		},
 	})
 }
+
+func TestProjectStatusFlatten(t *testing.T) {
+	t.Parallel()
+
+	type projectStatusModel struct {
+		ProjectStatus fwtypes.StringEnum[types.ProjectStatus] `tfsdk:"project_status"`
+	}
+
+	testCases := map[string]struct {
+		status          types.ProjectStatus
+		expectedWarning bool
+	}{
+		"known status": {
+			status: types.ProjectStatusActive,
+		},
+		"unknown status": {
+			status:          types.ProjectStatus("ARCHIVED"),
+			expectedWarning: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			var model projectStatusModel
+
+			diags := flex.Flatten(ctx, &datazone.GetProjectOutput{ProjectStatus: testCase.status}, &model)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := model.ProjectStatus.ValueString(), string(testCase.status); got != want {
+				t.Errorf("project_status = %q, want %q", got, want)
+			}
+
+			diags = tfdatazone.CheckProjectStatus("test", testCase.status)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := diags.WarningsCount() > 0, testCase.expectedWarning; got != want {
+				t.Errorf("warning = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestCheckDomainAvailable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		status        types.DomainStatus
+		expectedError bool
+	}{
+		"available": {
+			status: types.DomainStatusAvailable,
+		},
+		"creating": {
+			status:        types.DomainStatusCreating,
+			expectedError: true,
+		},
+		"deleting": {
+			status:        types.DomainStatusDeleting,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tfdatazone.CheckDomainAvailable("test", testCase.status)
+
+			if got, want := diags.HasError(), testCase.expectedError; got != want {
+				t.Errorf("error = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestNormalizeProjectDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior, current, expected basetypes.StringValue
+	}{
+		"cleared": {
+			prior:    basetypes.NewStringNull(),
+			current:  basetypes.NewStringValue(""),
+			expected: basetypes.NewStringNull(),
+		},
+		"null": {
+			prior:    basetypes.NewStringValue("desc"),
+			current:  basetypes.NewStringNull(),
+			expected: basetypes.NewStringNull(),
+		},
+		"trailing whitespace": {
+			prior:    basetypes.NewStringValue("desc\r\n"),
+			current:  basetypes.NewStringValue("desc"),
+			expected: basetypes.NewStringValue("desc\r\n"),
+		},
+		"changed": {
+			prior:    basetypes.NewStringNull(),
+			current:  basetypes.NewStringValue("desc \n"),
+			expected: basetypes.NewStringValue("desc"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfdatazone.NormalizeProjectDescription(testCase.prior, testCase.current), testCase.expected; !got.Equal(want) {
+				t.Errorf("NormalizeProjectDescription() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestProjectCreateTimeout(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"create": basetypes.StringType{},
+		"delete": basetypes.StringType{},
+	}
+
+	testCases := map[string]struct {
+		timeouts timeouts.Value
+		expected time.Duration
+	}{
+		"default": {
+			timeouts: timeouts.Value{Object: basetypes.NewObjectNull(attrTypes)},
+			expected: 30 * time.Minute,
+		},
+		"configured": {
+			timeouts: timeouts.Value{Object: basetypes.NewObjectValueMust(attrTypes, map[string]attr.Value{
+				"create": basetypes.NewStringValue("45m"),
+				"delete": basetypes.NewStringNull(),
+			})},
+			expected: 45 * time.Minute,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r, err := tfdatazone.ResourceProject(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			v, ok := r.(interface {
+				CreateTimeout(context.Context, timeouts.Value) time.Duration
+			})
+			if !ok {
+				t.Fatalf("%T does not implement CreateTimeout", r)
+			}
+
+			if got, want := v.CreateTimeout(ctx, testCase.timeouts), testCase.expected; got != want {
+				t.Errorf("CreateTimeout = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestStatusProject(t *testing.T) {
+	t.Parallel()
+
+	notFound := &types.ResourceNotFoundException{Message: aws.String("project not found")}
+	project := &datazone.GetProjectOutput{
+		Id:            aws.String("prj1234"),
+		ProjectStatus: types.ProjectStatusActive,
+	}
+
+	// The first reads after creation return not found and then a partial project.
+	responses := []struct {
+		output         *datazone.GetProjectOutput
+		err            error
+		expectedStatus string
+		expectedFound  bool
+	}{
+		{err: notFound},
+		{output: &datazone.GetProjectOutput{Id: aws.String("prj1234")}},
+		{output: project, expectedStatus: string(types.ProjectStatusActive), expectedFound: true},
+	}
+
+	var call int
+	conn := datazone.New(datazone.Options{
+		Region: "us-west-2", //lintignore:AWSAT003
+		APIOptions: []func(*middleware.Stack) error{
+			acctest.StubAPIResponses(func(any) (any, error) {
+				response := responses[call]
+				call++
+
+				return response.output, response.err
+			}),
+		},
+	})
+
+	refresh := tfdatazone.StatusProject(context.Background(), conn, "dzd_1234", "prj1234")
+
+	for i, response := range responses {
+		output, status, err := refresh()
+
+		if err != nil {
+			t.Fatalf("read %d: unexpected error: %s", i, err)
+		}
+
+		if got, want := status, response.expectedStatus; got != want {
+			t.Errorf("read %d: status = %q, want %q", i, got, want)
+		}
+
+		if got, want := output != nil, response.expectedFound; got != want {
+			t.Errorf("read %d: found = %t, want %t", i, got, want)
+		}
+	}
+}
+
+func TestProjectFailedStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := datazone.New(datazone.Options{
+		Region: "us-west-2", //lintignore:AWSAT003
+		APIOptions: []func(*middleware.Stack) error{
+			acctest.StubAPIResponses(func(any) (any, error) {
+				return &datazone.GetProjectOutput{
+					Id:            aws.String("prj1234"),
+					ProjectStatus: types.ProjectStatusDeleteFailed,
+					FailureReasons: []types.ProjectDeletionError{
+						{Code: aws.String("ResourcesStillExist"), Message: aws.String("Project has environments")},
+					},
+				}, nil
+			}),
+		},
+	})
+
+	// A failed project is found, with its status and failure reasons, rather than treated as gone.
+	output, status, err := tfdatazone.StatusProject(ctx, conn, "dzd_1234", "prj1234")()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := status, string(types.ProjectStatusDeleteFailed); got != want {
+		t.Errorf("status = %q, want %q", got, want)
+	}
+
+	if got, want := len(output.(*datazone.GetProjectOutput).FailureReasons), 1; got != want {
+		t.Errorf("failure reasons = %d, want %d", got, want)
+	}
+
+	// The delete waiter stops at the failed status and reports the failure reasons.
+	_, err = tfdatazone.WaitProjectDeleted(ctx, conn, "dzd_1234", "prj1234", 1*time.Minute)
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if want := "ResourcesStillExist: Project has environments"; !strings.Contains(err.Error(), want) {
+		t.Errorf("error %q does not contain %q", err, want)
+	}
+}
+
 func testAccCheckProjectDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
@@ -238,6 +519,90 @@ func TestAccDataZoneProject_update(t *testing.T) {
 	})
 }
 
+func TestAccDataZoneProject_descriptionTrailingWhitespace(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v datazone.GetProjectOutput
+	pName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_project.test"
+	description := "first line\r\nsecond line  \n"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_description(pName, dName, description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, description),
+				),
+			},
+			{
+				Config:   testAccProjectConfig_description(pName, dName, description),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccDataZoneProject_descriptionRemoved(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 datazone.GetProjectOutput
+	pName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_project.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_description(pName, dName, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "desc"),
+				),
+			},
+			{
+				Config: testAccProjectConfig_noDescription(pName, dName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectExists(ctx, resourceName, &v2),
+					testAccCheckProjectNotRecreated(&v1, &v2),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					func(s *terraform.State) error {
+						if v := aws.ToString(v2.Description); v != "" {
+							return fmt.Errorf("DataZone Project description = %q, want empty", v)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				Config:   testAccProjectConfig_noDescription(pName, dName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccAuthorizerImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -279,3 +644,73 @@ resource "aws_datazone_project" "test" {
 }
 `, pName))
 }
+
+func testAccProjectConfig_description(pName, dName, description string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(dName), fmt.Sprintf(`
+resource "aws_datazone_project" "test" {
+  domain_identifier   = aws_datazone_domain.test.id
+  name                = %[1]q
+  description         = %[2]q
+  skip_deletion_check = true
+}
+`, pName, description))
+}
+
+func testAccProjectConfig_noDescription(pName, dName string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(dName), fmt.Sprintf(`
+resource "aws_datazone_project" "test" {
+  domain_identifier   = aws_datazone_domain.test.id
+  name                = %[1]q
+  skip_deletion_check = true
+}
+`, pName))
+}
+
+func TestAccDataZoneProject_noOpPlan(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 datazone.GetProjectOutput
+	pName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_project.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_basic(pName, dName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_at"),
+				),
+			},
+			{
+				Config: testAccProjectConfig_basic(pName, dName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectExists(ctx, resourceName, &v2),
+					testAccCheckProjectNotRecreated(&v1, &v2),
+					func(s *terraform.State) error {
+						if before, after := aws.ToTime(v1.LastUpdatedAt), aws.ToTime(v2.LastUpdatedAt); !before.Equal(after) {
+							return fmt.Errorf("DataZone Project last_updated_at changed from %s to %s", before, after)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*datazone.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws.Config))
+
+	return datazone.NewFromConfig(cfg,
+		datazone.WithEndpointResolverV2(newEndpointResolverV2()),
+		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *datazone.Options) {
+			// DataZone is not yet available in every Region. Unless an endpoint override is configured,
+			// report a missing regional endpoint clearly rather than as an opaque DNS failure.
+			if aws.ToString(o.BaseEndpoint) == "" {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Initialize.Add(regionNotAvailableMiddleware(o.Region), middleware.Before)
+				})
+			}
+		},
+	), nil
+}
+
+func regionNotAvailableMiddleware(region string) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc("regionNotAvailable", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		if dnsErr, ok := errs.As[*net.DNSError](err); ok && dnsErr.IsNotFound {
+			err = fmt.Errorf("DataZone is not available in Region (%s): endpoint host %q not found; configure a DataZone endpoint override (endpoints { datazone = \"...\" }) to use DataZone from this Region: %w", region, dnsErr.Name, err)
+		}
+
+		return out, metadata, err
+	})
+}
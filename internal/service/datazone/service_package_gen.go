@@ -5,8 +5,6 @@ package datazone
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/datazone"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -16,10 +14,22 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceAssets,
+			Name:    "Assets",
+		},
 		{
 			Factory: newDataSourceEnvironmentBlueprint,
 			Name:    "Environment Blueprint",
 		},
+		{
+			Factory: newDataSourceFormType,
+			Name:    "Form Type",
+		},
+		{
+			Factory: newDataSourceListing,
+			Name:    "Listing",
+		},
 	}
 }
 
@@ -40,6 +50,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceEnvironment,
 			Name:    "Environment",
 		},
+		{
+			Factory: newResourceEnvironmentAction,
+			Name:    "Environment Action",
+		},
 		{
 			Factory: newResourceEnvironmentBlueprintConfiguration,
 			Name:    "Environment Blueprint Configuration",
@@ -60,6 +74,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceGlossaryTerm,
 			Name:    "Glossary Term",
 		},
+		{
+			Factory: newResourceGlossaryTerms,
+			Name:    "Glossary Terms",
+		},
 		{
 			Factory: newResourceProject,
 			Name:    "Project",
@@ -83,16 +101,6 @@ func (p *servicePackage) ServicePackageName() string {
 	return names.DataZone
 }
 
-// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
-func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*datazone.Client, error) {
-	cfg := *(config["aws_sdkv2_config"].(*aws.Config))
-
-	return datazone.NewFromConfig(cfg,
-		datazone.WithEndpointResolverV2(newEndpointResolverV2()),
-		withBaseEndpoint(config[names.AttrEndpoint].(string)),
-	), nil
-}
-
 func ServicePackage(ctx context.Context) conns.ServicePackage {
 	return &servicePackage{}
 }
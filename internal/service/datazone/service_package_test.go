@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/smithy-go/middleware"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+)
+
+func TestRegionNotAvailableMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err         error
+		expectedErr *regexp.Regexp
+	}{
+		"no error": {},
+		"host not found": {
+			err:         &net.DNSError{Err: "no such host", Name: "datazone.xx-west-1.api.aws", IsNotFound: true},
+			expectedErr: regexache.MustCompile(`DataZone is not available in Region \(xx-west-1\): endpoint host "datazone.xx-west-1.api.aws" not found`),
+		},
+		"lookup timeout": {
+			err:         &net.DNSError{Err: "i/o timeout", Name: "datazone.xx-west-1.api.aws", IsTimeout: true},
+			expectedErr: regexache.MustCompile(`^lookup datazone.xx-west-1.api.aws: i/o timeout$`),
+		},
+		"other error": {
+			err:         errors.New("test error"),
+			expectedErr: regexache.MustCompile(`^test error$`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			next := middleware.InitializeHandlerFunc(func(context.Context, middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, testCase.err
+			})
+
+			_, _, err := tfdatazone.RegionNotAvailableMiddleware("xx-west-1").HandleInitialize(context.Background(), middleware.InitializeInput{}, next)
+
+			if testCase.expectedErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !testCase.expectedErr.MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got %v", testCase.expectedErr, err)
+			}
+
+			if !errors.Is(err, testCase.err) {
+				t.Errorf("expected error to wrap %v", testCase.err)
+			}
+		})
+	}
+}
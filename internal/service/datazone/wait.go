@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"time"
+)
+
+const (
+	waiterMinPollInterval = 5 * time.Second
+	waiterMaxPollInterval = 30 * time.Second
+	// Minimum number of consecutive not-found results tolerated while a newly created or
+	// updated resource becomes visible.
+	waiterMinNotFoundChecks = 20
+	// Fraction of a waiter's timeout (1/n) during which a resource may still be reported as not found.
+	// Large domains can take several minutes to become consistent.
+	waiterNotFoundTimeoutDivisor = 4
+)
+
+// waiterPollInterval derives the polling interval from the waiter timeout,
+// so that longer timeouts poll less frequently.
+func waiterPollInterval(timeout time.Duration) time.Duration {
+	return min(max(timeout/120, waiterMinPollInterval), waiterMaxPollInterval)
+}
+
+// waiterNotFoundChecks returns the number of consecutive not-found results tolerated by a waiter,
+// covering a fixed fraction of the timeout at the derived polling interval.
+func waiterNotFoundChecks(timeout time.Duration) int {
+	return max(int(timeout/waiterNotFoundTimeoutDivisor/waiterPollInterval(timeout)), waiterMinNotFoundChecks)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"testing"
+	"time"
+
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+)
+
+func TestWaiterNotFoundWindow(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		timeout              time.Duration
+		expectedPollInterval time.Duration
+		expectedChecks       int
+	}{
+		{timeout: 1 * time.Minute, expectedPollInterval: 5 * time.Second, expectedChecks: 20},
+		{timeout: 10 * time.Minute, expectedPollInterval: 5 * time.Second, expectedChecks: 30},
+		{timeout: 30 * time.Minute, expectedPollInterval: 15 * time.Second, expectedChecks: 30},
+		{timeout: 2 * time.Hour, expectedPollInterval: 30 * time.Second, expectedChecks: 60},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.timeout.String(), func(t *testing.T) {
+			t.Parallel()
+
+			pollInterval := tfdatazone.WaiterPollInterval(testCase.timeout)
+			if got, want := pollInterval, testCase.expectedPollInterval; got != want {
+				t.Errorf("WaiterPollInterval(%s) = %s, want %s", testCase.timeout, got, want)
+			}
+
+			checks := tfdatazone.WaiterNotFoundChecks(testCase.timeout)
+			if got, want := checks, testCase.expectedChecks; got != want {
+				t.Errorf("WaiterNotFoundChecks(%s) = %d, want %d", testCase.timeout, got, want)
+			}
+
+			// The tolerated not-found window must never be shorter than a quarter of the timeout.
+			if window := time.Duration(checks) * pollInterval; window < testCase.timeout/4 {
+				t.Errorf("not-found window %s is shorter than %s", window, testCase.timeout/4)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,8 +15,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/docdb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,6 +27,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -47,6 +52,7 @@ func resourceCluster() *schema.Resource {
 				// from any API call, so we need to default skip_final_snapshot to true so
 				// that final_snapshot_identifier is not required
 				d.Set("skip_final_snapshot", true)
+				d.Set("delete_instances_on_destroy", false)
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -81,7 +87,7 @@ func resourceCluster() *schema.Resource {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Default:      1,
-				ValidateFunc: validation.IntAtMost(35),
+				ValidateFunc: validation.IntBetween(1, 35),
 			},
 			names.AttrClusterIdentifier: {
 				Type:          schema.TypeString,
@@ -120,13 +126,19 @@ func resourceCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"delete_instances_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrDeletionProtection: {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
 			"enabled_cloudwatch_logs_exports": {
-				Type:     schema.TypeList,
-				Optional: true,
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentCloudWatchLogsExports,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 					ValidateFunc: validation.StringInSlice([]string{
@@ -183,7 +195,7 @@ func resourceCluster() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: tfkms.ValidateKeyOrAlias,
 			},
 			"master_password": {
 				Type:      schema.TypeString,
@@ -199,7 +211,6 @@ func resourceCluster() *schema.Resource {
 			names.AttrPort: {
 				Type:         schema.TypeInt,
 				Optional:     true,
-				ForceNew:     true,
 				Default:      27017,
 				ValidateFunc: validation.IntBetween(1150, 65535),
 			},
@@ -207,18 +218,14 @@ func resourceCluster() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
+				StateFunc:    normalizeWindow,
 				ValidateFunc: verify.ValidOnceADayWindowFormat,
 			},
 			names.AttrPreferredMaintenanceWindow: {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				StateFunc: func(val interface{}) string {
-					if val == nil {
-						return ""
-					}
-					return strings.ToLower(val.(string))
-				},
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				StateFunc:    normalizeWindow,
 				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
 			},
 			"reader_endpoint": {
@@ -306,10 +313,241 @@ func resourceCluster() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"writer_instance": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffEngineVersion,
+			customizeDiffParameterGroupFamily,
+			customizeDiffBackupWindow,
+			customizeDiffKMSKeyAlias,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func customizeDiffEngineVersion(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange(names.AttrEngineVersion) || !d.NewValueKnown(names.AttrEngineVersion) {
+		return nil
+	}
+
+	o, n := d.GetChange(names.AttrEngineVersion)
+	if isMajorVersionUpgrade(o.(string), n.(string)) && !d.Get(names.AttrAllowMajorVersionUpgrade).(bool) {
+		return fmt.Errorf("changing %s from %q to %q is a major version upgrade, set %s to true to allow it", names.AttrEngineVersion, o, n, names.AttrAllowMajorVersionUpgrade)
 	}
+
+	return nil
+}
+
+// customizeDiffParameterGroupFamily checks that a configured cluster parameter group's family matches
+// the engine version's, as otherwise the error is only reported when the cluster is created or modified.
+// The check is best-effort: a parameter group that does not exist yet, e.g. one created in the same apply,
+// or a family that can't be read is left for the API to validate.
+func customizeDiffParameterGroupFamily(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.GetRawConfig().GetAttr("db_cluster_parameter_group_name").IsNull() {
+		return nil
+	}
+
+	if !d.HasChanges("db_cluster_parameter_group_name", names.AttrEngineVersion) || !d.NewValueKnown("db_cluster_parameter_group_name") || !d.NewValueKnown(names.AttrEngineVersion) {
+		return nil
+	}
+
+	name, engineVersion := d.Get("db_cluster_parameter_group_name").(string), d.Get(names.AttrEngineVersion).(string)
+	if name == "" || engineVersion == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).DocDBClient(ctx)
+
+	parameterGroup, err := findDBClusterParameterGroupByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[WARN] reading DocumentDB Cluster Parameter Group (%s), skipping family check: %s", name, err)
+		return nil
+	}
+
+	input := &docdb.DescribeDBEngineVersionsInput{
+		Engine:        aws.String(d.Get(names.AttrEngine).(string)),
+		EngineVersion: aws.String(engineVersion),
+	}
+	version, err := findEngineVersion(ctx, conn, input)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[WARN] reading DocumentDB Engine Version (%s), skipping parameter group family check: %s", engineVersion, err)
+		return nil
+	}
+
+	if got, want := aws.ToString(parameterGroup.DBParameterGroupFamily), aws.ToString(version.DBParameterGroupFamily); got != "" && want != "" && got != want {
+		return fmt.Errorf("db_cluster_parameter_group_name: DocumentDB Cluster Parameter Group (%s) family %q does not match engine_version %q family %q", name, got, engineVersion, want)
+	}
+
+	return nil
+}
+
+func customizeDiffBackupWindow(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("preferred_backup_window") || !d.NewValueKnown(names.AttrPreferredMaintenanceWindow) {
+		return nil
+	}
+
+	backupWindow, maintenanceWindow := d.Get("preferred_backup_window").(string), d.Get(names.AttrPreferredMaintenanceWindow).(string)
+	if backupWindow == "" || maintenanceWindow == "" {
+		return nil
+	}
+
+	overlap, err := windowsOverlap(backupWindow, maintenanceWindow)
+	if err != nil {
+		return err
+	}
+
+	if overlap {
+		return fmt.Errorf("preferred_backup_window (%s) must not overlap %s (%s)", backupWindow, names.AttrPreferredMaintenanceWindow, maintenanceWindow)
+	}
+
+	return nil
+}
+
+func normalizeWindow(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+
+	return strings.ToLower(strings.TrimSpace(v.(string)))
+}
+
+const (
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
+)
+
+// windowsOverlap reports whether a daily "hh24:mi-hh24:mi" backup window overlaps a weekly "ddd:hh24:mi-ddd:hh24:mi" maintenance window.
+// Both windows may wrap, the backup window past midnight and the maintenance window past the end of the week.
+func windowsOverlap(backupWindow, maintenanceWindow string) (bool, error) {
+	backupStart, backupEnd, ok := strings.Cut(normalizeWindow(backupWindow), "-")
+	if !ok {
+		return false, fmt.Errorf("invalid backup window: %q", backupWindow)
+	}
+
+	bStart, err := parseWindowMinute(backupStart)
+	if err != nil {
+		return false, err
+	}
+
+	bEnd, err := parseWindowMinute(backupEnd)
+	if err != nil {
+		return false, err
+	}
+
+	if bEnd <= bStart {
+		bEnd += minutesPerDay
+	}
+
+	maintenanceStart, maintenanceEnd, ok := strings.Cut(normalizeWindow(maintenanceWindow), "-")
+	if !ok {
+		return false, fmt.Errorf("invalid maintenance window: %q", maintenanceWindow)
+	}
+
+	mStart, err := parseWindowWeekMinute(maintenanceStart)
+	if err != nil {
+		return false, err
+	}
+
+	mEnd, err := parseWindowWeekMinute(maintenanceEnd)
+	if err != nil {
+		return false, err
+	}
+
+	if mEnd <= mStart {
+		mEnd += minutesPerWeek
+	}
+
+	// Compare the backup window on every day of the week against the maintenance window in the previous, current and next week,
+	// covering a maintenance window that wraps past the end of the week and a Sunday backup window that wraps into Monday.
+	for day := 0; day < 7; day++ {
+		offset := day * minutesPerDay
+		for _, week := range []int{-minutesPerWeek, 0, minutesPerWeek} {
+			if bStart+offset < mEnd+week && mStart+week < bEnd+offset {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func parseWindowMinute(v string) (int, error) {
+	t, err := time.Parse("15:04", v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid window time %q: %w", v, err)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func parseWindowWeekMinute(v string) (int, error) {
+	day, hhmm, ok := strings.Cut(v, ":")
+	if !ok {
+		return 0, fmt.Errorf("invalid window time: %q", v)
+	}
+
+	i := slices.Index([]string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}, day)
+	if i < 0 {
+		return 0, fmt.Errorf("invalid window day: %q", day)
+	}
+
+	minute, err := parseWindowMinute(hhmm)
+	if err != nil {
+		return 0, err
+	}
+
+	return i*minutesPerDay + minute, nil
+}
+
+// customizeDiffKMSKeyAlias suppresses the replacement of a cluster whose kms_key_id is configured as a KMS alias
+// that still refers to the key ARN held in state.
+func customizeDiffKMSKeyAlias(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange(names.AttrKMSKeyID) || !d.NewValueKnown(names.AttrKMSKeyID) {
+		return nil
+	}
+
+	o, n := d.GetChange(names.AttrKMSKeyID)
+	if o, n := o.(string), n.(string); o != "" && n != "" && !tfkms.IsKeyARN(n) {
+		keyARN, err := resolveKMSKeyARN(ctx, meta.(*conns.AWSClient).KMSClient(ctx), n)
+
+		if err != nil {
+			return err
+		}
+
+		if keyARN == o {
+			return d.Clear(names.AttrKMSKeyID)
+		}
+	}
+
+	return nil
+}
+
+// isMajorVersionUpgrade returns whether the engine versions differ in their major component, e.g. "4.0.0" and "5.0.0".
+func isMajorVersionUpgrade(o, n string) bool {
+	if o == "" || n == "" {
+		return false
+	}
+
+	major := func(v string) string {
+		major, _, _ := strings.Cut(v, ".")
+		return major
+	}
+
+	return major(o) != major(n)
 }
 
 func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -322,6 +560,12 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		create.WithDefaultPrefix("tf-"),
 	).Generate()
 
+	// A KMS alias is resolved to its key ARN, which is what the API returns on read.
+	kmsKeyID, err := resolveKMSKeyARN(ctx, meta.(*conns.AWSClient).KMSClient(ctx), d.Get(names.AttrKMSKeyID).(string))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DocumentDB Cluster (%s): %s", identifier, err)
+	}
+
 	// Some API calls (e.g. RestoreDBClusterFromSnapshot do not support all
 	// parameters to correctly apply all settings in one pass. For missing
 	// parameters or unsupported configurations, we may need to call
@@ -367,8 +611,8 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			input.EngineVersion = aws.String(v.(string))
 		}
 
-		if v, ok := d.GetOk(names.AttrKMSKeyID); ok {
-			input.KmsKeyId = aws.String(v.(string))
+		if kmsKeyID != "" {
+			input.KmsKeyId = aws.String(kmsKeyID)
 		}
 
 		if v, ok := d.GetOk("master_password"); ok {
@@ -439,8 +683,8 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			input.RestoreType = aws.String(v)
 		}
 
-		if v, ok := d.GetOk(names.AttrKMSKeyID); ok {
-			input.KmsKeyId = aws.String(v.(string))
+		if kmsKeyID != "" {
+			input.KmsKeyId = aws.String(kmsKeyID)
 		}
 
 		if v, ok := d.GetOk(names.AttrPort); ok {
@@ -513,8 +757,8 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			input.GlobalClusterIdentifier = aws.String(v.(string))
 		}
 
-		if v, ok := d.GetOk(names.AttrKMSKeyID); ok {
-			input.KmsKeyId = aws.String(v.(string))
+		if kmsKeyID != "" {
+			input.KmsKeyId = aws.String(kmsKeyID)
 		}
 
 		if v, ok := d.GetOk(names.AttrPort); ok {
@@ -631,6 +875,11 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		securityGroupIDs = append(securityGroupIDs, aws.ToString(v.VpcSecurityGroupId))
 	}
 	d.Set(names.AttrVPCSecurityGroupIDs, securityGroupIDs)
+	d.Set("writer_instance", clusterWriterInstance(dbc))
+
+	if v := clusterPromotionTierWarning(dbc); v != "" {
+		diags = sdkdiag.AppendWarningf(diags, "DocumentDB Cluster (%s) %s, so the instance promoted on failover may not be the one intended. Set promotion_tier on aws_docdb_cluster_instance so that exactly one instance has promotion tier 0", d.Id(), v)
+	}
 
 	return diags
 }
@@ -639,7 +888,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DocDBClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "global_cluster_identifier", "skip_final_snapshot") {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "delete_instances_on_destroy", "global_cluster_identifier", "skip_final_snapshot") {
 		input := &docdb.ModifyDBClusterInput{
 			ApplyImmediately:    aws.Bool(d.Get(names.AttrApplyImmediately).(bool)),
 			DBClusterIdentifier: aws.String(d.Id()),
@@ -669,10 +918,16 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
 		}
 
+		// A password change is applied in place, immediately or in the next maintenance window per ApplyImmediately.
+		// The cluster passes through resetting-master-credentials, which the waiter below treats as pending.
 		if d.HasChange("master_password") {
 			input.MasterUserPassword = aws.String(d.Get("master_password").(string))
 		}
 
+		if d.HasChange(names.AttrPort) {
+			input.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
+		}
+
 		if d.HasChange("preferred_backup_window") {
 			input.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
 		}
@@ -718,9 +973,32 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			return sdkdiag.AppendErrorf(diags, "modifying DocumentDB Cluster (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitDBClusterAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		dbc, err := waitDBClusterAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for DocumentDB Cluster (%s) update: %s", d.Id(), err)
 		}
+
+		// Static parameters in the new parameter group only take effect on each instance after it has been rebooted.
+		if d.HasChange("db_cluster_parameter_group_name") {
+			if ids := clusterMembersPendingReboot(dbc); len(ids) > 0 {
+				diags = sdkdiag.AppendWarningf(diags, "DocumentDB Cluster (%s) instances %q must be rebooted for the parameters of DB Cluster Parameter Group (%s) to take effect, for example by setting force_reboot on aws_docdb_cluster_instance", d.Id(), ids, d.Get("db_cluster_parameter_group_name").(string))
+			}
+		}
+
+		// Instances are upgraded along with the cluster. Wait for them so that dependent resources see the new version.
+		if d.HasChange(names.AttrEngineVersion) && d.Get(names.AttrApplyImmediately).(bool) {
+			if err := waitDBClusterInstancesEngineVersionUpgraded(ctx, conn, d.Id(), d.Get(names.AttrEngineVersion).(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for DocumentDB Cluster (%s) instances engine version upgrade: %s", d.Id(), err)
+			}
+		}
+
+		// A port change only takes effect on each instance after it has been rebooted.
+		if d.HasChange(names.AttrPort) && d.Get(names.AttrApplyImmediately).(bool) {
+			if err := rebootClusterInstances(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
 	}
 
 	if d.HasChange("global_cluster_identifier") {
@@ -767,6 +1045,17 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.Get("delete_instances_on_destroy").(bool) {
+		// DeleteDBCluster would fail, so don't delete the instances of a cluster that can't be deleted.
+		if d.Get(names.AttrDeletionProtection).(bool) {
+			return sdkdiag.AppendErrorf(diags, "deleting DocumentDB Cluster (%s): deletion_protection is enabled, disable it before destroying the cluster and its instances", d.Id())
+		}
+
+		if err := deleteClusterInstances(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting DocumentDB Cluster: %s", d.Id())
 	_, err := tfresource.RetryWhen(ctx, d.Timeout(schema.TimeoutDelete),
 		func() (interface{}, error) {
@@ -800,36 +1089,63 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
-func expandCloudwatchLogsExportConfiguration(d *schema.ResourceData) *awstypes.CloudwatchLogsExportConfiguration { // nosemgrep:ci.caps0-in-func-name
-	oraw, nraw := d.GetChange("enabled_cloudwatch_logs_exports")
-	o := oraw.([]interface{})
-	n := nraw.([]interface{})
+// deleteClusterInstances deletes all of a cluster's member instances, including any not managed by Terraform,
+// and waits for them to be deleted.
+func deleteClusterInstances(ctx context.Context, conn *docdb.Client, clusterID string, timeout time.Duration) error {
+	instances, err := findDBInstancesByClusterID(ctx, conn, clusterID)
 
-	create, disable := diffCloudWatchLogsExportConfiguration(o, n)
+	if tfresource.NotFound(err) {
+		return nil
+	}
 
-	return &awstypes.CloudwatchLogsExportConfiguration{
-		EnableLogTypes:  flex.ExpandStringValueList(create),
-		DisableLogTypes: flex.ExpandStringValueList(disable),
+	if err != nil {
+		return fmt.Errorf("reading DocumentDB Cluster (%s) instances: %w", clusterID, err)
 	}
-}
 
-func diffCloudWatchLogsExportConfiguration(old, new []interface{}) ([]interface{}, []interface{}) {
-	add := make([]interface{}, 0)
-	disable := make([]interface{}, 0)
+	for _, v := range instances {
+		id := aws.ToString(v.DBInstanceIdentifier)
+
+		log.Printf("[DEBUG] Deleting DocumentDB Cluster (%s) Instance: %s", clusterID, id)
+		_, err := conn.DeleteDBInstance(ctx, &docdb.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(id),
+		})
 
-	for _, n := range new {
-		if idx := tfslices.IndexOf(old, n.(string)); idx == -1 {
-			add = append(add, n)
+		if errs.IsA[*awstypes.DBInstanceNotFoundFault](err) || errs.IsAErrorMessageContains[*awstypes.InvalidDBInstanceStateFault](err, "is already being deleted") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting DocumentDB Cluster (%s) Instance (%s): %w", clusterID, id, err)
 		}
 	}
 
-	for _, o := range old {
-		if idx := tfslices.IndexOf(new, o.(string)); idx == -1 {
-			disable = append(disable, o)
+	for _, v := range instances {
+		id := aws.ToString(v.DBInstanceIdentifier)
+
+		if _, err := waitDBInstanceDeleted(ctx, conn, id, timeout); err != nil {
+			return fmt.Errorf("waiting for DocumentDB Cluster (%s) Instance (%s) delete: %w", clusterID, id, err)
 		}
 	}
 
-	return add, disable
+	return nil
+}
+
+func expandCloudwatchLogsExportConfiguration(d *schema.ResourceData) *awstypes.CloudwatchLogsExportConfiguration { // nosemgrep:ci.caps0-in-func-name
+	o, n := d.GetChange("enabled_cloudwatch_logs_exports")
+	os, ns := schema.NewSet(schema.HashString, o.([]interface{})), schema.NewSet(schema.HashString, n.([]interface{}))
+
+	return &awstypes.CloudwatchLogsExportConfiguration{
+		EnableLogTypes:  flex.ExpandStringValueSet(ns.Difference(os)),
+		DisableLogTypes: flex.ExpandStringValueSet(os.Difference(ns)),
+	}
+}
+
+// suppressEquivalentCloudWatchLogsExports suppresses differences in the order of the
+// enabled_cloudwatch_logs_exports list, which the API treats as a set.
+func suppressEquivalentCloudWatchLogsExports(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("enabled_cloudwatch_logs_exports")
+
+	return schema.NewSet(schema.HashString, o.([]interface{})).Equal(schema.NewSet(schema.HashString, n.([]interface{})))
 }
 
 func removeClusterFromGlobalCluster(ctx context.Context, conn *docdb.Client, clusterARN, globalClusterID string, timeout time.Duration) error {
@@ -860,6 +1176,112 @@ func removeClusterFromGlobalCluster(ctx context.Context, conn *docdb.Client, clu
 	return nil
 }
 
+// rebootClusterInstances reboots the cluster's instances one at a time, readers first and the writer last,
+// waiting for each instance to become available before rebooting the next.
+func rebootClusterInstances(ctx context.Context, conn *docdb.Client, clusterID string, timeout time.Duration) error {
+	dbc, err := findDBClusterByID(ctx, conn, clusterID)
+
+	if err != nil {
+		return fmt.Errorf("reading DocumentDB Cluster (%s): %w", clusterID, err)
+	}
+
+	var readers, writers []string
+	for _, v := range dbc.DBClusterMembers {
+		if aws.ToBool(v.IsClusterWriter) {
+			writers = append(writers, aws.ToString(v.DBInstanceIdentifier))
+		} else {
+			readers = append(readers, aws.ToString(v.DBInstanceIdentifier))
+		}
+	}
+
+	for _, id := range append(readers, writers...) {
+		if err := rebootDBInstance(ctx, conn, id, timeout); err != nil {
+			return fmt.Errorf("DocumentDB Cluster (%s): %w", clusterID, err)
+		}
+	}
+
+	return nil
+}
+
+// clusterWriterInstance returns the identifier of the cluster's current primary instance, or "" if the cluster has no instances.
+func clusterWriterInstance(apiObject *awstypes.DBCluster) string {
+	if apiObject == nil {
+		return ""
+	}
+
+	for _, v := range apiObject.DBClusterMembers {
+		if aws.ToBool(v.IsClusterWriter) {
+			return aws.ToString(v.DBInstanceIdentifier)
+		}
+	}
+
+	return ""
+}
+
+// clusterPromotionTierWarning returns why the cluster's instances do not have a single preferred
+// promotion target, or "" if they do. Instances sharing the same promotion tier, including the default
+// of 0, are treated as intentional; only clusters whose instances use differing tiers are checked for
+// either no instance at tier 0 or more than one instance at the lowest tier.
+func clusterPromotionTierWarning(apiObject *awstypes.DBCluster) string {
+	if apiObject == nil || len(apiObject.DBClusterMembers) < 2 {
+		return ""
+	}
+
+	tiers := make(map[int32][]string)
+	for _, v := range apiObject.DBClusterMembers {
+		tier := aws.ToInt32(v.PromotionTier)
+		tiers[tier] = append(tiers[tier], aws.ToString(v.DBInstanceIdentifier))
+	}
+
+	if len(tiers) < 2 {
+		return ""
+	}
+
+	lowest := slices.Min(tfmaps.Keys(tiers))
+	ids := tiers[lowest]
+	slices.Sort(ids)
+
+	switch {
+	case lowest != 0:
+		return fmt.Sprintf("has no instance with promotion tier 0 (lowest promotion tier is %d)", lowest)
+	case len(ids) > 1:
+		return fmt.Sprintf("instances %q share the lowest promotion tier (%d)", ids, lowest)
+	default:
+		return ""
+	}
+}
+
+func clusterMembersPendingReboot(apiObject *awstypes.DBCluster) []string {
+	var ids []string
+
+	if apiObject == nil {
+		return ids
+	}
+
+	for _, v := range apiObject.DBClusterMembers {
+		if aws.ToString(v.DBClusterParameterGroupStatus) == dbClusterParameterGroupStatusPendingReboot {
+			ids = append(ids, aws.ToString(v.DBInstanceIdentifier))
+		}
+	}
+
+	return ids
+}
+
+// resolveKMSKeyARN returns the ARN of the KMS key identified by a key ID, alias name or alias ARN.
+func resolveKMSKeyARN(ctx context.Context, conn *kms.Client, keyID string) (string, error) {
+	if keyID == "" || tfkms.IsKeyARN(keyID) {
+		return keyID, nil
+	}
+
+	key, err := tfkms.FindKeyByID(ctx, conn, keyID)
+
+	if err != nil {
+		return "", fmt.Errorf("reading KMS Key (%s): %w", keyID, err)
+	}
+
+	return aws.ToString(key.Arn), nil
+}
+
 func findDBClusterByID(ctx context.Context, conn *docdb.Client, id string) (*awstypes.DBCluster, error) {
 	input := &docdb.DescribeDBClustersInput{
 		DBClusterIdentifier: aws.String(id),
@@ -969,6 +1391,41 @@ func waitDBClusterAvailable(ctx context.Context, conn *docdb.Client, id string,
 	return nil, err
 }
 
+// statusDBClusterInstancesEngineVersion reports the cluster as upgrading until every instance is available on the engine version.
+func statusDBClusterInstancesEngineVersion(ctx context.Context, conn *docdb.Client, clusterID, engineVersion string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDBInstancesByClusterID(ctx, conn, clusterID)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range output {
+			if aws.ToString(v.EngineVersion) != engineVersion || aws.ToString(v.DBInstanceStatus) != clusterStatusAvailable {
+				return output, clusterStatusUpgrading, nil
+			}
+		}
+
+		return output, clusterStatusAvailable, nil
+	}
+}
+
+func waitDBClusterInstancesEngineVersionUpgraded(ctx context.Context, conn *docdb.Client, clusterID, engineVersion string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{clusterStatusUpgrading},
+		Target:                    []string{clusterStatusAvailable},
+		Refresh:                   statusDBClusterInstancesEngineVersion(ctx, conn, clusterID, engineVersion),
+		Timeout:                   timeout,
+		MinTimeout:                10 * time.Second,
+		Delay:                     30 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 func waitDBClusterDeleted(ctx context.Context, conn *docdb.Client, id string, timeout time.Duration) (*awstypes.DBCluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
@@ -5,7 +5,9 @@ package docdb
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/docdb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -23,6 +26,7 @@ import (
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -37,7 +41,7 @@ func resourceClusterInstance() *schema.Resource {
 		DeleteWithoutTimeout: resourceClusterInstanceDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceClusterInstanceImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -84,6 +88,10 @@ func resourceClusterInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"db_cluster_parameter_group_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"dbi_resource_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -107,6 +115,10 @@ func resourceClusterInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"force_reboot": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			names.AttrIdentifier: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -130,6 +142,42 @@ func resourceClusterInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"pending_modified_values": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_retention_period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ca_cert_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_subnet_group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrEngineVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrPort: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrStorageType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"performance_insights_kms_key_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -178,10 +226,72 @@ func resourceClusterInstance() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffAvailabilityZone,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func customizeDiffAvailabilityZone(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange(names.AttrAvailabilityZone) || !d.NewValueKnown(names.AttrAvailabilityZone) || !d.NewValueKnown(names.AttrClusterIdentifier) {
+		return nil
+	}
+
+	az, clusterID := d.Get(names.AttrAvailabilityZone).(string), d.Get(names.AttrClusterIdentifier).(string)
+	if az == "" || clusterID == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).DocDBClient(ctx)
+
+	err := checkClusterInstanceAvailabilityZone(ctx, conn, clusterID, az)
+
+	// The cluster may be created in the same apply, in which case the check is repeated on create.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// checkClusterInstanceAvailabilityZone returns an error if the Availability Zone has no subnet in the cluster's subnet group.
+func checkClusterInstanceAvailabilityZone(ctx context.Context, conn *docdb.Client, clusterID, az string) error {
+	cluster, err := findDBClusterByID(ctx, conn, clusterID)
+
+	if err != nil {
+		return err
+	}
+
+	subnetGroupName := aws.ToString(cluster.DBSubnetGroup)
+	subnetGroup, err := findDBSubnetGroupByName(ctx, conn, subnetGroupName)
+
+	if err != nil {
+		return fmt.Errorf("reading DocumentDB Subnet Group (%s): %w", subnetGroupName, err)
+	}
+
+	return validateAvailabilityZoneInSubnetGroup(az, subnetGroup)
+}
+
+func validateAvailabilityZoneInSubnetGroup(az string, subnetGroup *awstypes.DBSubnetGroup) error {
+	var azs []string
+	for _, v := range subnetGroup.Subnets {
+		if v.SubnetAvailabilityZone == nil {
+			continue
+		}
+		if name := aws.ToString(v.SubnetAvailabilityZone.Name); !slices.Contains(azs, name) {
+			azs = append(azs, name)
+		}
+	}
+
+	if !slices.Contains(azs, az) {
+		slices.Sort(azs)
+		return fmt.Errorf("%s: %q is not an Availability Zone of DocumentDB Subnet Group (%s) in VPC (%s), valid values are %q", names.AttrAvailabilityZone, az, aws.ToString(subnetGroup.DBSubnetGroupName), aws.ToString(subnetGroup.VpcId), azs)
+	}
+
+	return nil
+}
+
 func resourceClusterInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DocDBClient(ctx)
@@ -202,6 +312,10 @@ func resourceClusterInstanceCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if v, ok := d.GetOk(names.AttrAvailabilityZone); ok {
+		if err := checkClusterInstanceAvailabilityZone(ctx, conn, aws.ToString(input.DBClusterIdentifier), v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating DocumentDB Cluster Instance (%s): %s", identifier, err)
+		}
+
 		input.AvailabilityZone = aws.String(v.(string))
 	}
 
@@ -284,6 +398,9 @@ func resourceClusterInstanceRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("identifier_prefix", create.NamePrefixFromName(aws.ToString(db.DBInstanceIdentifier)))
 	d.Set("instance_class", db.DBInstanceClass)
 	d.Set(names.AttrKMSKeyID, db.KmsKeyId)
+	if err := d.Set("pending_modified_values", flattenPendingModifiedValues(db.PendingModifiedValues)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting pending_modified_values: %s", err)
+	}
 	// The AWS API does not expose 'PerformanceInsightsKMSKeyId'  the line below should be uncommented
 	// as soon as it is available in the DescribeDBClusters output.
 	//d.Set("performance_insights_kms_key_id", db.PerformanceInsightsKMSKeyId)
@@ -295,6 +412,7 @@ func resourceClusterInstanceRead(ctx context.Context, d *schema.ResourceData, me
 	if v := tfslices.Filter(dbc.DBClusterMembers, func(v awstypes.DBClusterMember) bool {
 		return aws.ToString(v.DBInstanceIdentifier) == d.Id()
 	}); len(v) == 1 {
+		d.Set("db_cluster_parameter_group_status", v[0].DBClusterParameterGroupStatus)
 		d.Set("writer", v[0].IsClusterWriter)
 	}
 
@@ -305,7 +423,7 @@ func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DocDBClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrApplyImmediately, "force_reboot", names.AttrTags, names.AttrTagsAll) {
 		input := &docdb.ModifyDBInstanceInput{
 			ApplyImmediately:     aws.Bool(d.Get(names.AttrApplyImmediately).(bool)),
 			DBInstanceIdentifier: aws.String(d.Id()),
@@ -356,9 +474,44 @@ func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	// Reboot when force_reboot is switched on, e.g. to apply static cluster parameters.
+	if d.HasChange("force_reboot") && d.Get("force_reboot").(bool) {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+
+		if err := rebootDBInstance(ctx, conn, d.Id(), timeout); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		clusterID := d.Get(names.AttrClusterIdentifier).(string)
+		if _, err := waitDBInstanceParameterGroupInSync(ctx, conn, clusterID, d.Id(), timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for DocumentDB Cluster Instance (%s) parameters to apply: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceClusterInstanceRead(ctx, d, meta)...)
 }
 
+// rebootDBInstance reboots the instance and waits for it to become available.
+func rebootDBInstance(ctx context.Context, conn *docdb.Client, id string, timeout time.Duration) error {
+	input := &docdb.RebootDBInstanceInput{
+		DBInstanceIdentifier: aws.String(id),
+	}
+
+	_, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.InvalidDBInstanceStateFault](ctx, timeout, func() (interface{}, error) {
+		return conn.RebootDBInstance(ctx, input)
+	}, "is not in available state")
+
+	if err != nil {
+		return fmt.Errorf("rebooting DocumentDB Cluster Instance (%s): %w", id, err)
+	}
+
+	if _, err := waitDBInstanceAvailable(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for DocumentDB Cluster Instance (%s) reboot: %w", id, err)
+	}
+
+	return nil
+}
+
 func resourceClusterInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DocDBClient(ctx)
@@ -383,6 +536,36 @@ func resourceClusterInstanceDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+func resourceClusterInstanceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).DocDBClient(ctx)
+
+	id := d.Id()
+	db, err := findDBInstanceByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		// Importing by cluster identifier is a common mistake. List the cluster's members so that each can be imported.
+		if members, err := findDBInstancesByClusterID(ctx, conn, id); err == nil && len(members) > 0 {
+			ids := tfslices.ApplyToAll(members, func(v awstypes.DBInstance) string {
+				return aws.ToString(v.DBInstanceIdentifier)
+			})
+
+			return nil, fmt.Errorf("DocumentDB Cluster Instance (%[1]s) not found: %[1]s is a DocumentDB Cluster, import each of its instances instead: %[2]s", id, strings.Join(ids, ", "))
+		}
+
+		return nil, fmt.Errorf("DocumentDB Cluster Instance (%s) not found", id)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading DocumentDB Cluster Instance (%s): %w", id, err)
+	}
+
+	if aws.ToString(db.DBClusterIdentifier) == "" {
+		return nil, fmt.Errorf("DB Instance (%s) is not a member of a DocumentDB Cluster", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func findDBInstanceByID(ctx context.Context, conn *docdb.Client, id string) (*awstypes.DBInstance, error) {
 	input := &docdb.DescribeDBInstancesInput{
 		DBInstanceIdentifier: aws.String(id),
@@ -403,6 +586,19 @@ func findDBInstanceByID(ctx context.Context, conn *docdb.Client, id string) (*aw
 	return output, nil
 }
 
+func findDBInstancesByClusterID(ctx context.Context, conn *docdb.Client, clusterID string) ([]awstypes.DBInstance, error) {
+	input := &docdb.DescribeDBInstancesInput{
+		Filters: []awstypes.Filter{
+			{
+				Name:   aws.String("db-cluster-id"),
+				Values: []string{clusterID},
+			},
+		},
+	}
+
+	return findDBInstances(ctx, conn, input)
+}
+
 func findDBInstance(ctx context.Context, conn *docdb.Client, input *docdb.DescribeDBInstancesInput) (*awstypes.DBInstance, error) {
 	output, err := findDBInstances(ctx, conn, input)
 
@@ -509,3 +705,69 @@ func waitDBInstanceDeleted(ctx context.Context, conn *docdb.Client, id string, t
 
 	return nil, err
 }
+
+func statusDBInstanceParameterGroup(ctx context.Context, conn *docdb.Client, clusterID, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDBClusterByID(ctx, conn, clusterID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		member, err := tfresource.AssertSingleValueResult(tfslices.Filter(output.DBClusterMembers, func(v awstypes.DBClusterMember) bool {
+			return aws.ToString(v.DBInstanceIdentifier) == id
+		}))
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return member, aws.ToString(member.DBClusterParameterGroupStatus), nil
+	}
+}
+
+// waitDBInstanceParameterGroupInSync waits for a rebooted instance's cluster parameter group to be in sync.
+// pending-reboot isn't waited on as the instance has already been rebooted, so it's reported as an unexpected state.
+func waitDBInstanceParameterGroupInSync(ctx context.Context, conn *docdb.Client, clusterID, id string, timeout time.Duration) (*awstypes.DBClusterMember, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{dbClusterParameterGroupStatusApplying},
+		Target:     []string{dbClusterParameterGroupStatusInSync},
+		Refresh:    statusDBInstanceParameterGroup(ctx, conn, clusterID, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DBClusterMember); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenPendingModifiedValues(apiObject *awstypes.PendingModifiedValues) []interface{} {
+	if apiObject == nil || itypes.IsZero(apiObject) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"backup_retention_period": aws.ToInt32(apiObject.BackupRetentionPeriod),
+		"ca_cert_identifier":      aws.ToString(apiObject.CACertificateIdentifier),
+		"db_subnet_group_name":    aws.ToString(apiObject.DBSubnetGroupName),
+		names.AttrEngineVersion:   aws.ToString(apiObject.EngineVersion),
+		"instance_class":          aws.ToString(apiObject.DBInstanceClass),
+		names.AttrPort:            aws.ToInt32(apiObject.Port),
+		names.AttrStorageType:     aws.ToString(apiObject.StorageType),
+	}
+
+	return []interface{}{tfMap}
+}
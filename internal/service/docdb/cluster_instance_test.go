@@ -6,9 +6,13 @@ package docdb_test
 import (
 	"context"
 	"fmt"
+	"maps"
+	"regexp"
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -109,6 +113,41 @@ func TestAccDocDBClusterInstance_disappears(t *testing.T) {
 	})
 }
 
+func TestAccDocDBClusterInstance_importValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DBInstance
+	resourceName := "aws_docdb_cluster_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	clusterName := rName + "-cluster"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterInstanceConfig_importValidation(rName, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: rName + "-missing",
+				ExpectError:   regexache.MustCompile(`DocumentDB Cluster Instance \(.+-missing\) not found`),
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: clusterName,
+				ExpectError:   regexache.MustCompile(fmt.Sprintf(`is a DocumentDB Cluster, import each of its instances instead: %s`, rName)),
+			},
+		},
+	})
+}
+
 func TestAccDocDBClusterInstance_identifierGenerated(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.DBInstance
@@ -223,6 +262,38 @@ func TestAccDocDBClusterInstance_tags(t *testing.T) {
 	})
 }
 
+func TestAccDocDBClusterInstance_tagsOnCreateDefaultTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DBInstance
+	resourceName := "aws_docdb_cluster_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1(acctest.CtProviderKey1, acctest.CtProviderValue1),
+					testAccClusterInstanceConfig_tags1(rName, acctest.CtResourceKey1, acctest.CtResourceValue1),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v),
+					testAccCheckClusterInstanceTags(ctx, &v, map[string]string{
+						acctest.CtProviderKey1: acctest.CtProviderValue1,
+						acctest.CtResourceKey1: acctest.CtResourceValue1,
+					}),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsAllPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all."+acctest.CtProviderKey1, acctest.CtProviderValue1),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDocDBClusterInstance_performanceInsights(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.DBInstance
@@ -291,6 +362,24 @@ func TestAccDocDBClusterInstance_az(t *testing.T) {
 	})
 }
 
+func TestAccDocDBClusterInstance_azNotInSubnetGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterInstanceConfig_azNotInSubnetGroup(rName),
+				ExpectError: regexache.MustCompile(`is not an Availability Zone of DocumentDB Subnet Group`),
+			},
+		},
+	})
+}
+
 func TestAccDocDBClusterInstance_kmsKey(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.DBInstance
@@ -362,6 +451,177 @@ func TestAccDocDBClusterInstance_copyTagsToSnapshot(t *testing.T) {
 	})
 }
 
+func TestAccDocDBClusterInstance_pendingModifiedValues(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DBInstance
+	resourceName := "aws_docdb_cluster_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterInstanceConfig_instanceClass(rName, "db.t3.medium", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.t3.medium"),
+					resource.TestCheckResourceAttr(resourceName, "pending_modified_values.#", "0"),
+				),
+			},
+			{
+				Config: testAccClusterInstanceConfig_instanceClass(rName, "db.r5.large", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrApplyImmediately, acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.t3.medium"),
+					resource.TestCheckResourceAttr(resourceName, "pending_modified_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pending_modified_values.0.instance_class", "db.r5.large"),
+				),
+				// The instance class change is deferred to the next maintenance window.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDocDBClusterInstance_forceReboot(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DBInstance
+	resourceName := "aws_docdb_cluster_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterInstanceConfig_forceReboot(rName, "enabled", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "db_cluster_parameter_group_status", "in-sync"),
+					resource.TestCheckResourceAttr(resourceName, "force_reboot", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccClusterInstanceConfig_forceReboot(rName, "disabled", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "db_cluster_parameter_group_status", "in-sync"),
+					resource.TestCheckResourceAttr(resourceName, "force_reboot", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					names.AttrApplyImmediately,
+					"force_reboot",
+				},
+			},
+		},
+	})
+}
+
+func TestAccDocDBClusterInstance_promotionTier(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2, v3 awstypes.DBInstance
+	resourceName1 := "aws_docdb_cluster_instance.test.0"
+	resourceName2 := "aws_docdb_cluster_instance.test.1"
+	resourceName3 := "aws_docdb_cluster_instance.test.2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterInstanceConfig_promotionTiers(rName, 1, 2, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName1, &v1),
+					testAccCheckClusterInstanceExists(ctx, resourceName2, &v2),
+					testAccCheckClusterInstanceExists(ctx, resourceName3, &v3),
+					resource.TestCheckResourceAttr(resourceName1, "promotion_tier", "1"),
+					resource.TestCheckResourceAttr(resourceName2, "promotion_tier", "2"),
+					resource.TestCheckResourceAttr(resourceName3, "promotion_tier", "3"),
+				),
+			},
+			{
+				Config: testAccClusterInstanceConfig_promotionTiers(rName, 3, 2, 15),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName1, &v1),
+					testAccCheckClusterInstanceExists(ctx, resourceName2, &v2),
+					testAccCheckClusterInstanceExists(ctx, resourceName3, &v3),
+					resource.TestCheckResourceAttr(resourceName1, "promotion_tier", "3"),
+					resource.TestCheckResourceAttr(resourceName2, "promotion_tier", "2"),
+					resource.TestCheckResourceAttr(resourceName3, "promotion_tier", "15"),
+				),
+			},
+			{
+				Config:      testAccClusterInstanceConfig_promotionTiers(rName, 3, 2, 16),
+				ExpectError: regexache.MustCompile(`expected promotion_tier to be in the range \(0 - 15\)`),
+			},
+		},
+	})
+}
+
+func TestValidateAvailabilityZoneInSubnetGroup(t *testing.T) {
+	t.Parallel()
+
+	subnetGroup := &awstypes.DBSubnetGroup{
+		DBSubnetGroupName: aws.String("test"),
+		Subnets: []awstypes.Subnet{
+			{SubnetAvailabilityZone: &awstypes.AvailabilityZone{Name: aws.String("us-west-2b")}},
+			{SubnetAvailabilityZone: &awstypes.AvailabilityZone{Name: aws.String("us-west-2a")}},
+			{SubnetAvailabilityZone: &awstypes.AvailabilityZone{Name: aws.String("us-west-2a")}},
+		},
+		VpcId: aws.String("vpc-12345678"),
+	}
+
+	testCases := map[string]struct {
+		az            string
+		expectedError *regexp.Regexp
+	}{
+		"in subnet group": {
+			az: "us-west-2a",
+		},
+		"not in subnet group": {
+			az:            "us-west-2c",
+			expectedError: regexache.MustCompile(`"us-west-2c" is not an Availability Zone of DocumentDB Subnet Group \(test\) in VPC \(vpc-12345678\), valid values are \["us-west-2a" "us-west-2b"\]`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfdocdb.ValidateAvailabilityZoneInSubnetGroup(testCase.az, subnetGroup)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func testAccCheckClusterInstanceDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBClient(ctx)
@@ -409,6 +669,30 @@ func testAccCheckClusterInstanceExists(ctx context.Context, n string, v *awstype
 	}
 }
 
+// testAccCheckClusterInstanceTags verifies the tags on the instance as seen by the API,
+// independent of the provider's tag handling on Read.
+func testAccCheckClusterInstanceTags(ctx context.Context, v *awstypes.DBInstance, want map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBClient(ctx)
+
+		output, err := conn.ListTagsForResource(ctx, &docdb.ListTagsForResourceInput{
+			ResourceName: v.DBInstanceArn,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		got := tfdocdb.KeyValueTags(ctx, output.TagList).IgnoreAWS().Map()
+
+		if !maps.Equal(got, want) {
+			return fmt.Errorf("DocumentDB Cluster Instance (%s) tags: got %v, want %v", aws.ToString(v.DBInstanceIdentifier), got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccClusterInstanceConfig_base(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_docdb_cluster" "test" {
@@ -448,6 +732,35 @@ resource "aws_docdb_cluster_instance" "test" {
 `, rName))
 }
 
+func testAccClusterInstanceConfig_instanceClass(rName, instanceClass string, applyImmediately bool) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName), fmt.Sprintf(`
+resource "aws_docdb_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_docdb_cluster.test.id
+  instance_class     = %[2]q
+  apply_immediately  = %[3]t
+}
+`, rName, instanceClass, applyImmediately))
+}
+
+func testAccClusterInstanceConfig_promotionTiers(rName string, tier1, tier2, tier3 int) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName), fmt.Sprintf(`
+locals {
+  promotion_tiers = [%[2]d, %[3]d, %[4]d]
+}
+
+resource "aws_docdb_cluster_instance" "test" {
+  count = 3
+
+  identifier         = "%[1]s-${count.index}"
+  cluster_identifier = aws_docdb_cluster.test.id
+  instance_class     = data.aws_docdb_orderable_db_instance.test.instance_class
+  apply_immediately  = true
+  promotion_tier     = local.promotion_tiers[count.index]
+}
+`, rName, tier1, tier2, tier3))
+}
+
 func testAccClusterInstanceConfig_identifierGenerated(rName string) string {
 	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName), `
 resource "aws_docdb_cluster_instance" "test" {
@@ -556,6 +869,35 @@ resource "aws_docdb_cluster_instance" "test" {
 `, rName))
 }
 
+func testAccClusterInstanceConfig_azNotInSubnetGroup(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_docdb_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier   = %[1]q
+  db_subnet_group_name = aws_docdb_subnet_group.test.name
+  master_password      = "avoid-plaintext-passwords"
+  master_username      = "tfacctest"
+  skip_final_snapshot  = true
+}
+
+data "aws_docdb_orderable_db_instance" "test" {
+  engine                     = aws_docdb_cluster.test.engine
+  preferred_instance_classes = ["db.t3.medium", "db.4tg.medium", "db.r5.large", "db.r6g.large"]
+}
+
+resource "aws_docdb_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_docdb_cluster.test.id
+  instance_class     = data.aws_docdb_orderable_db_instance.test.instance_class
+  availability_zone  = data.aws_availability_zones.available.names[2]
+}
+`, rName))
+}
+
 func testAccClusterInstanceConfig_kmsKey(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -613,3 +955,51 @@ resource "aws_docdb_cluster_instance" "test" {
 }
 `, rName, flag))
 }
+
+func testAccClusterInstanceConfig_forceReboot(rName, tls string, forceReboot bool) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster_parameter_group" "test" {
+  name   = %[1]q
+  family = "docdb5.0"
+
+  parameter {
+    name         = "tls"
+    value        = %[2]q
+    apply_method = "pending-reboot"
+  }
+}
+
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier              = %[1]q
+  engine_version                  = "5.0.0"
+  db_cluster_parameter_group_name = aws_docdb_cluster_parameter_group.test.name
+  master_password                 = "avoid-plaintext-passwords"
+  master_username                 = "tfacctest"
+  skip_final_snapshot             = true
+}
+
+data "aws_docdb_orderable_db_instance" "test" {
+  engine                     = aws_docdb_cluster.test.engine
+  engine_version             = aws_docdb_cluster.test.engine_version
+  preferred_instance_classes = ["db.t3.medium", "db.4tg.medium", "db.r5.large", "db.r6g.large"]
+}
+
+resource "aws_docdb_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_docdb_cluster.test.id
+  instance_class     = data.aws_docdb_orderable_db_instance.test.instance_class
+  apply_immediately  = true
+  force_reboot       = %[3]t
+}
+`, rName, tls, forceReboot)
+}
+
+func testAccClusterInstanceConfig_importValidation(rName, clusterName string) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(clusterName), fmt.Sprintf(`
+resource "aws_docdb_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_docdb_cluster.test.id
+  instance_class     = data.aws_docdb_orderable_db_instance.test.instance_class
+}
+`, rName))
+}
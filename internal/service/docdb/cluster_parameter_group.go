@@ -5,6 +5,7 @@ package docdb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"slices"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/docdb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -99,7 +101,10 @@ func resourceClusterParameterGroup() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffClusterParameterApplyMethods,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -181,6 +186,7 @@ func resourceClusterParameterGroupUpdate(ctx context.Context, d *schema.Resource
 		o, n := d.GetChange(names.AttrParameter)
 		os, ns := o.(*schema.Set), n.(*schema.Set)
 
+		// Only send the parameters that were added or changed.
 		if parameters := expandParameters(ns.Difference(os).List()); len(parameters) > 0 {
 			err := modifyClusterParameterGroupParameters(ctx, conn, d.Id(), parameters)
 
@@ -242,6 +248,64 @@ func modifyClusterParameterGroupParameters(ctx context.Context, conn *docdb.Clie
 	return nil
 }
 
+// customizeDiffClusterParameterApplyMethods verifies added or changed parameters against the engine defaults
+// of the parameter group family: each parameter must be modifiable, and static parameters can only be applied on reboot.
+// The check is best-effort: if the engine defaults can't be read, e.g. without rds:DescribeEngineDefaultClusterParameters,
+// the parameters are left for the API to validate.
+func customizeDiffClusterParameterApplyMethods(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange(names.AttrParameter) || !d.NewValueKnown(names.AttrParameter) || !d.NewValueKnown(names.AttrFamily) {
+		return nil
+	}
+
+	o, n := d.GetChange(names.AttrParameter)
+	parameters := expandParameters(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+
+	if len(parameters) == 0 {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).DocDBClient(ctx)
+	family := d.Get(names.AttrFamily).(string)
+	defaults, err := findEngineDefaultClusterParameters(ctx, conn, family)
+
+	if err != nil {
+		log.Printf("[WARN] reading DocumentDB engine default cluster parameters (%s), skipping parameter apply method checks: %s", family, err)
+		return nil
+	}
+
+	return validateClusterParameterApplyMethods(parameters, defaults)
+}
+
+func validateClusterParameterApplyMethods(parameters, defaults []awstypes.Parameter) error {
+	defaultsByName := make(map[string]awstypes.Parameter, len(defaults))
+	for _, v := range defaults {
+		defaultsByName[aws.ToString(v.ParameterName)] = v
+	}
+
+	var validationErrs []error
+
+	for _, v := range parameters {
+		name := aws.ToString(v.ParameterName)
+		engineDefault, ok := defaultsByName[name]
+
+		// Unknown parameters are left for the API to reject.
+		if !ok {
+			continue
+		}
+
+		if !aws.ToBool(engineDefault.IsModifiable) {
+			validationErrs = append(validationErrs, fmt.Errorf("parameter (%s) is not modifiable", name))
+			continue
+		}
+
+		if aws.ToString(engineDefault.ApplyType) == parameterApplyTypeStatic && v.ApplyMethod == awstypes.ApplyMethodImmediate {
+			validationErrs = append(validationErrs, fmt.Errorf("parameter (%s) is static and requires apply_method %q", name, awstypes.ApplyMethodPendingReboot))
+		}
+	}
+
+	return errors.Join(validationErrs...)
+}
+
 func findDBClusterParameterGroupByName(ctx context.Context, conn *docdb.Client, name string) (*awstypes.DBClusterParameterGroup, error) {
 	input := &docdb.DescribeDBClusterParameterGroupsInput{
 		DBClusterParameterGroupName: aws.String(name),
@@ -327,3 +391,36 @@ func findDBClusterParameters(ctx context.Context, conn *docdb.Client, input *doc
 
 	return output, nil
 }
+
+func findEngineDefaultClusterParameters(ctx context.Context, conn *docdb.Client, family string) ([]awstypes.Parameter, error) {
+	input := &docdb.DescribeEngineDefaultClusterParametersInput{
+		DBParameterGroupFamily: aws.String(family),
+	}
+	var output []awstypes.Parameter
+
+	for {
+		page, err := conn.DescribeEngineDefaultClusterParameters(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil || page.EngineDefaults == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		for _, v := range page.EngineDefaults.Parameters {
+			if !itypes.IsZero(&v) {
+				output = append(output, v)
+			}
+		}
+
+		if aws.ToString(page.EngineDefaults.Marker) == "" {
+			break
+		}
+
+		input.Marker = page.EngineDefaults.Marker
+	}
+
+	return output, nil
+}
@@ -7,9 +7,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
+	"github.com/aws/smithy-go/middleware"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -134,6 +139,24 @@ func TestAccDocDBClusterParameterGroup_namePrefix(t *testing.T) {
 	})
 }
 
+func TestAccDocDBClusterParameterGroup_nameAndNamePrefixConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterParameterGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterParameterGroupConfig_nameAndNamePrefix(rName, "tf-acc-test-prefix-"),
+				ExpectError: regexache.MustCompile(`"name": conflicts with name_prefix`),
+			},
+		},
+	})
+}
+
 func TestAccDocDBClusterParameterGroup_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.DBClusterParameterGroup
@@ -236,6 +259,186 @@ func TestAccDocDBClusterParameterGroup_parameter(t *testing.T) {
 	})
 }
 
+func TestAccDocDBClusterParameterGroup_staticParameterApplyMethod(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DBClusterParameterGroup
+	resourceName := "aws_docdb_cluster_parameter_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterParameterGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterParameterGroupConfig_parameterApplyMethod(rName, "tls", "disabled", string(awstypes.ApplyMethodImmediate)),
+				ExpectError: regexache.MustCompile(`parameter \(tls\) is static and requires apply_method "pending-reboot"`),
+			},
+			{
+				Config: testAccClusterParameterGroupConfig_parameterApplyMethod(rName, "tls", "disabled", string(awstypes.ApplyMethodPendingReboot)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterParameterGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"apply_method":  "pending-reboot",
+						names.AttrName:  "tls",
+						names.AttrValue: "disabled",
+					}),
+				),
+			},
+			{
+				Config:      testAccClusterParameterGroupConfig_parameterApplyMethod(rName, "tls", names.AttrEnabled, string(awstypes.ApplyMethodImmediate)),
+				ExpectError: regexache.MustCompile(`parameter \(tls\) is static and requires apply_method "pending-reboot"`),
+			},
+		},
+	})
+}
+
+func TestValidateClusterParameterApplyMethods(t *testing.T) {
+	t.Parallel()
+
+	defaults := []awstypes.Parameter{
+		{
+			ApplyType:     aws.String("static"),
+			IsModifiable:  aws.Bool(true),
+			ParameterName: aws.String("tls"),
+		},
+		{
+			ApplyType:     aws.String("dynamic"),
+			IsModifiable:  aws.Bool(true),
+			ParameterName: aws.String("profiler"),
+		},
+		{
+			ApplyType:     aws.String("static"),
+			IsModifiable:  aws.Bool(false),
+			ParameterName: aws.String("fixed"),
+		},
+	}
+
+	testCases := map[string]struct {
+		parameters    []awstypes.Parameter
+		expectedError string
+	}{
+		"static pending-reboot": {
+			parameters: []awstypes.Parameter{
+				{ApplyMethod: awstypes.ApplyMethodPendingReboot, ParameterName: aws.String("tls"), ParameterValue: aws.String("disabled")},
+			},
+		},
+		"static immediate": {
+			parameters: []awstypes.Parameter{
+				{ApplyMethod: awstypes.ApplyMethodImmediate, ParameterName: aws.String("tls"), ParameterValue: aws.String("disabled")},
+			},
+			expectedError: `parameter \(tls\) is static`,
+		},
+		"dynamic immediate": {
+			parameters: []awstypes.Parameter{
+				{ApplyMethod: awstypes.ApplyMethodImmediate, ParameterName: aws.String("profiler"), ParameterValue: aws.String("enabled")},
+			},
+		},
+		"dynamic pending-reboot": {
+			parameters: []awstypes.Parameter{
+				{ApplyMethod: awstypes.ApplyMethodPendingReboot, ParameterName: aws.String("profiler"), ParameterValue: aws.String("enabled")},
+			},
+		},
+		"not modifiable": {
+			parameters: []awstypes.Parameter{
+				{ApplyMethod: awstypes.ApplyMethodPendingReboot, ParameterName: aws.String("fixed"), ParameterValue: aws.String("x")},
+			},
+			expectedError: `parameter \(fixed\) is not modifiable`,
+		},
+		"unknown": {
+			parameters: []awstypes.Parameter{
+				{ApplyMethod: awstypes.ApplyMethodImmediate, ParameterName: aws.String("unknown"), ParameterValue: aws.String("x")},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfdocdb.ValidateClusterParameterApplyMethods(testCase.parameters, defaults)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !regexache.MustCompile(testCase.expectedError).MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestModifyClusterParameterGroupParametersBatches(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var batches [][]string
+	conn := docdb.New(docdb.Options{
+		Region: "us-west-2", //lintignore:AWSAT003
+		APIOptions: []func(*middleware.Stack) error{
+			acctest.StubAPIResponses(func(in any) (any, error) {
+				input, ok := in.(*docdb.ModifyDBClusterParameterGroupInput)
+				if !ok {
+					return nil, fmt.Errorf("unexpected input type %T", in)
+				}
+
+				var parameterNames []string
+				for _, v := range input.Parameters {
+					parameterNames = append(parameterNames, aws.ToString(v.ParameterName))
+				}
+				batches = append(batches, parameterNames)
+
+				return &docdb.ModifyDBClusterParameterGroupOutput{}, nil
+			}),
+		},
+	})
+
+	const n = 50
+	var parameters []awstypes.Parameter
+	for i := range n {
+		parameters = append(parameters, awstypes.Parameter{
+			ApplyMethod:    awstypes.ApplyMethodPendingReboot,
+			ParameterName:  aws.String(fmt.Sprintf("parameter%02d", i)),
+			ParameterValue: aws.String(strconv.Itoa(i)),
+		})
+	}
+
+	if err := tfdocdb.ModifyClusterParameterGroupParameters(ctx, conn, "test", parameters); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(batches), 3; got != want {
+		t.Fatalf("batches = %d, want %d", got, want)
+	}
+
+	for i, want := range []int{20, 20, 10} {
+		if got := len(batches[i]); got != want {
+			t.Errorf("batch %d size = %d, want %d", i, got, want)
+		}
+	}
+
+	var applied []string
+	for _, v := range batches {
+		applied = append(applied, v...)
+	}
+
+	for i, v := range parameters {
+		if got, want := applied[i], aws.ToString(v.ParameterName); got != want {
+			t.Errorf("parameter %d = %s, want %s", i, got, want)
+		}
+	}
+}
+
 func TestAccDocDBClusterParameterGroup_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.DBClusterParameterGroup
@@ -359,6 +562,16 @@ resource "aws_docdb_cluster_parameter_group" "test" {
 `, namePrefix)
 }
 
+func testAccClusterParameterGroupConfig_nameAndNamePrefix(rName, namePrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster_parameter_group" "test" {
+  name        = %[1]q
+  name_prefix = %[2]q
+  family      = "docdb3.6"
+}
+`, rName, namePrefix)
+}
+
 func testAccClusterParameterGroupConfig_description(rName, description string) string {
 	return fmt.Sprintf(`
 resource "aws_docdb_cluster_parameter_group" "test" {
@@ -398,6 +611,21 @@ resource "aws_docdb_cluster_parameter_group" "test" {
 `, rName, pName, pValue)
 }
 
+func testAccClusterParameterGroupConfig_parameterApplyMethod(rName, pName, pValue, applyMethod string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster_parameter_group" "test" {
+  name   = %[1]q
+  family = "docdb3.6"
+
+  parameter {
+    name         = %[2]q
+    value        = %[3]q
+    apply_method = %[4]q
+  }
+}
+`, rName, pName, pValue, applyMethod)
+}
+
 func testAccClusterParameterGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_docdb_cluster_parameter_group" "test" {
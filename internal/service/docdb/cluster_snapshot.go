@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/docdb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,16 +18,22 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKResource("aws_docdb_cluster_snapshot")
+// @SDKResource("aws_docdb_cluster_snapshot", name="Cluster Snapshot")
+// @Tags(identifierAttribute="db_cluster_snapshot_arn")
 func ResourceClusterSnapshot() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterSnapshotCreate,
 		ReadWithoutTimeout:   resourceClusterSnapshotRead,
+		UpdateWithoutTimeout: resourceClusterSnapshotUpdate,
 		DeleteWithoutTimeout: resourceClusterSnapshotDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -45,9 +52,11 @@ func ResourceClusterSnapshot() *schema.Resource {
 			},
 			"db_cluster_identifier": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validClusterIdentifier,
+				ExactlyOneOf: []string{"db_cluster_identifier", "source_db_cluster_snapshot_arn"},
 			},
 			"db_cluster_snapshot_arn": {
 				Type:     schema.TypeString,
@@ -68,20 +77,33 @@ func ResourceClusterSnapshot() *schema.Resource {
 				Computed: true,
 			},
 			names.AttrKMSKeyID: {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				RequiredWith: []string{"source_db_cluster_snapshot_arn"},
 			},
 			names.AttrPort: {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"shared_accounts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"snapshot_type": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"source_db_cluster_snapshot_arn": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"db_cluster_identifier", "source_db_cluster_snapshot_arn"},
 			},
 			names.AttrStatus: {
 				Type:     schema.TypeString,
@@ -91,11 +113,15 @@ func ResourceClusterSnapshot() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrVPCID: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
 
@@ -104,15 +130,60 @@ func resourceClusterSnapshotCreate(ctx context.Context, d *schema.ResourceData,
 	conn := meta.(*conns.AWSClient).DocDBClient(ctx)
 
 	clusterSnapshotID := d.Get("db_cluster_snapshot_identifier").(string)
-	input := &docdb.CreateDBClusterSnapshotInput{
-		DBClusterIdentifier:         aws.String(d.Get("db_cluster_identifier").(string)),
-		DBClusterSnapshotIdentifier: aws.String(clusterSnapshotID),
-	}
 
-	_, err := conn.CreateDBClusterSnapshot(ctx, input)
+	if v, ok := d.GetOk("source_db_cluster_snapshot_arn"); ok {
+		sourceARN := v.(string)
+		input := &docdb.CopyDBClusterSnapshotInput{
+			SourceDBClusterSnapshotIdentifier: aws.String(sourceARN),
+			Tags:                              getTagsIn(ctx),
+			TargetDBClusterSnapshotIdentifier: aws.String(clusterSnapshotID),
+		}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating DocumentDB Cluster Snapshot (%s): %s", clusterSnapshotID, err)
+		region := meta.(*conns.AWSClient).Region
+
+		if v, ok := d.GetOk(names.AttrKMSKeyID); ok {
+			kmsKeyARN, err := arn.Parse(v.(string))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "parsing KMS key ARN (%s): %s", v.(string), err)
+			}
+
+			if kmsKeyARN.Region != region {
+				return sdkdiag.AppendErrorf(diags, "KMS key (%s) must be in the destination Region (%s)", v.(string), region)
+			}
+
+			input.KmsKeyId = aws.String(v.(string))
+		}
+
+		parsedARN, err := arn.Parse(sourceARN)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "parsing source DocumentDB Cluster Snapshot ARN (%s): %s", sourceARN, err)
+		}
+
+		// Copies from another Region require a pre-signed URL for the source Region.
+		// Setting SourceRegion causes the AWS SDK to generate it.
+		if parsedARN.Region != region {
+			input.SourceRegion = aws.String(parsedARN.Region)
+		}
+
+		_, err = conn.CopyDBClusterSnapshot(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "copying DocumentDB Cluster Snapshot (%s) to (%s): %s", sourceARN, clusterSnapshotID, err)
+		}
+	} else {
+		input := &docdb.CreateDBClusterSnapshotInput{
+			DBClusterIdentifier:         aws.String(d.Get("db_cluster_identifier").(string)),
+			DBClusterSnapshotIdentifier: aws.String(clusterSnapshotID),
+			Tags:                        getTagsIn(ctx),
+		}
+
+		_, err := conn.CreateDBClusterSnapshot(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating DocumentDB Cluster Snapshot (%s): %s", clusterSnapshotID, err)
+		}
 	}
 
 	d.SetId(clusterSnapshotID)
@@ -121,6 +192,20 @@ func resourceClusterSnapshotCreate(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "waiting for DocumentDB Cluster Snapshot (%s) create: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("shared_accounts"); ok && v.(*schema.Set).Len() > 0 {
+		input := &docdb.ModifyDBClusterSnapshotAttributeInput{
+			AttributeName:               aws.String(clusterSnapshotAttributeNameRestore),
+			DBClusterSnapshotIdentifier: aws.String(d.Id()),
+			ValuesToAdd:                 flex.ExpandStringValueSet(v.(*schema.Set)),
+		}
+
+		_, err := conn.ModifyDBClusterSnapshotAttribute(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying DocumentDB Cluster Snapshot (%s) attribute: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceClusterSnapshotRead(ctx, d, meta)...)
 }
 
@@ -154,11 +239,56 @@ func resourceClusterSnapshotRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set(names.AttrStorageEncrypted, snapshot.StorageEncrypted)
 	d.Set(names.AttrVPCID, snapshot.VpcId)
 
+	attribute, err := findClusterSnapshotAttributeByTwoPartKey(ctx, conn, d.Id(), clusterSnapshotAttributeNameRestore)
+	switch {
+	case err == nil:
+		d.Set("shared_accounts", attribute.AttributeValues)
+	case tfresource.NotFound(err):
+	default:
+		return sdkdiag.AppendErrorf(diags, "reading DocumentDB Cluster Snapshot (%s) attribute: %s", d.Id(), err)
+	}
+
 	return diags
 }
 
+func resourceClusterSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DocDBClient(ctx)
+
+	if d.HasChange("shared_accounts") {
+		// Only manual snapshots can be shared. Copy any other snapshot to a manual snapshot first.
+		if v := d.Get("snapshot_type").(string); v != clusterSnapshotTypeManual {
+			return sdkdiag.AppendErrorf(diags, "DocumentDB Cluster Snapshot (%s) has snapshot type %q and cannot be shared, copy it with source_db_cluster_snapshot_arn and share the copy instead", d.Id(), v)
+		}
+
+		o, n := d.GetChange("shared_accounts")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := ns.Difference(os), os.Difference(ns)
+		input := &docdb.ModifyDBClusterSnapshotAttributeInput{
+			AttributeName:               aws.String(clusterSnapshotAttributeNameRestore),
+			DBClusterSnapshotIdentifier: aws.String(d.Id()),
+			ValuesToAdd:                 flex.ExpandStringValueSet(add),
+			ValuesToRemove:              flex.ExpandStringValueSet(del),
+		}
+
+		_, err := conn.ModifyDBClusterSnapshotAttribute(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying DocumentDB Cluster Snapshot (%s) attribute: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceClusterSnapshotRead(ctx, d, meta)...)
+}
+
 func resourceClusterSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+
+	// Only manual snapshots can be deleted. Automated snapshots are removed by DocumentDB once they pass the cluster's backup retention period.
+	if d.Get("snapshot_type").(string) == clusterSnapshotTypeAutomated {
+		return sdkdiag.AppendErrorf(diags, "DocumentDB Cluster Snapshot (%s) is an automated snapshot and cannot be deleted, remove it from state with `terraform state rm` instead", d.Id())
+	}
+
 	conn := meta.(*conns.AWSClient).DocDBClient(ctx)
 
 	log.Printf("[DEBUG] Deleting DocumentDB Cluster Snapshot: %s", d.Id())
@@ -235,6 +365,47 @@ func findClusterSnapshots(ctx context.Context, conn *docdb.Client, input *docdb.
 	return output, nil
 }
 
+func findClusterSnapshotAttributeByTwoPartKey(ctx context.Context, conn *docdb.Client, id, attributeName string) (*awstypes.DBClusterSnapshotAttribute, error) {
+	input := &docdb.DescribeDBClusterSnapshotAttributesInput{
+		DBClusterSnapshotIdentifier: aws.String(id),
+	}
+
+	return findClusterSnapshotAttribute(ctx, conn, input, func(v *awstypes.DBClusterSnapshotAttribute) bool {
+		return aws.ToString(v.AttributeName) == attributeName
+	})
+}
+
+func findClusterSnapshotAttribute(ctx context.Context, conn *docdb.Client, input *docdb.DescribeDBClusterSnapshotAttributesInput, filter tfslices.Predicate[*awstypes.DBClusterSnapshotAttribute]) (*awstypes.DBClusterSnapshotAttribute, error) {
+	output, err := findClusterSnapshotAttributes(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findClusterSnapshotAttributes(ctx context.Context, conn *docdb.Client, input *docdb.DescribeDBClusterSnapshotAttributesInput, filter tfslices.Predicate[*awstypes.DBClusterSnapshotAttribute]) ([]awstypes.DBClusterSnapshotAttribute, error) {
+	output, err := conn.DescribeDBClusterSnapshotAttributes(ctx, input)
+
+	if errs.IsA[*awstypes.DBClusterSnapshotNotFoundFault](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DBClusterSnapshotAttributesResult == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfslices.Filter(output.DBClusterSnapshotAttributesResult.DBClusterSnapshotAttributes, tfslices.PredicateValue(filter)), nil
+}
+
 func statusClusterSnapshot(ctx context.Context, conn *docdb.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findClusterSnapshotByID(ctx, conn, id)
@@ -6,6 +6,7 @@ package docdb_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/YakDriver/regexache"
@@ -42,6 +43,7 @@ func TestAccDocDBClusterSnapshot_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrEngineVersion),
 					resource.TestCheckResourceAttr(resourceName, names.AttrKMSKeyID, ""),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrPort),
+					resource.TestCheckResourceAttr(resourceName, "shared_accounts.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "snapshot_type", "manual"),
 					resource.TestCheckResourceAttr(resourceName, "source_db_cluster_snapshot_arn", ""),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "available"),
@@ -58,6 +60,99 @@ func TestAccDocDBClusterSnapshot_basic(t *testing.T) {
 	})
 }
 
+func TestAccDocDBClusterSnapshot_sharedAccounts(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbClusterSnapshot awstypes.DBClusterSnapshot
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdb_cluster_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckClusterSnapshotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSnapshotConfig_sharedAccounts(rName, `"all"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotExists(ctx, resourceName, &dbClusterSnapshot),
+					resource.TestCheckResourceAttr(resourceName, "shared_accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "shared_accounts.*", "all"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClusterSnapshotConfig_sharedAccounts(rName, "data.aws_caller_identity.alternate.account_id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotExists(ctx, resourceName, &dbClusterSnapshot),
+					resource.TestCheckResourceAttr(resourceName, "shared_accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "shared_accounts.*", "data.aws_caller_identity.alternate", names.AttrAccountID),
+				),
+			},
+			{
+				Config: testAccClusterSnapshotConfig_sharedAccounts(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotExists(ctx, resourceName, &dbClusterSnapshot),
+					resource.TestCheckResourceAttr(resourceName, "shared_accounts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDocDBClusterSnapshot_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbClusterSnapshot awstypes.DBClusterSnapshot
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdb_cluster_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterSnapshotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSnapshotConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotExists(ctx, resourceName, &dbClusterSnapshot),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClusterSnapshotConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotExists(ctx, resourceName, &dbClusterSnapshot),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccClusterSnapshotConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotExists(ctx, resourceName, &dbClusterSnapshot),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDocDBClusterSnapshot_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dbClusterSnapshot awstypes.DBClusterSnapshot
@@ -82,6 +177,62 @@ func TestAccDocDBClusterSnapshot_disappears(t *testing.T) {
 	})
 }
 
+func TestAccDocDBClusterSnapshot_crossRegionCopy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbClusterSnapshot awstypes.DBClusterSnapshot
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdb_cluster_snapshot.test"
+	sourceResourceName := "aws_docdb_cluster_snapshot.source"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckClusterSnapshotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSnapshotConfig_crossRegionCopy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotExists(ctx, resourceName, &dbClusterSnapshot),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "db_cluster_snapshot_arn", "rds", regexache.MustCompile(`cluster-snapshot:.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "db_cluster_identifier", sourceResourceName, "db_cluster_identifier"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_cluster_snapshot_arn", sourceResourceName, "db_cluster_snapshot_arn"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "available"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestClusterSnapshotDeleteAutomated(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := tfdocdb.ResourceClusterSnapshot()
+	d := r.TestResourceData()
+	d.SetId("rds:tf-acc-test-2024-01-01-00-00")
+	d.Set("snapshot_type", "automated")
+
+	// The guard runs before any API call, so no client is needed.
+	diags := r.DeleteWithoutTimeout(ctx, d, nil)
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	if got, want := diags[0].Summary, "automated snapshot and cannot be deleted"; !strings.Contains(got, want) {
+		t.Errorf("error %q does not contain %q", got, want)
+	}
+}
+
 func testAccCheckClusterSnapshotDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBClient(ctx)
@@ -150,3 +301,108 @@ resource "aws_docdb_cluster_snapshot" "test" {
 }
 `, rName))
 }
+
+func testAccClusterSnapshotConfig_sharedAccounts(rName, sharedAccount string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_docdb_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier   = %[1]q
+  db_subnet_group_name = aws_docdb_subnet_group.test.name
+  master_password      = "avoid-plaintext-passwords"
+  master_username      = "tfacctest"
+  skip_final_snapshot  = true
+}
+
+resource "aws_docdb_cluster_snapshot" "test" {
+  db_cluster_identifier          = aws_docdb_cluster.test.id
+  db_cluster_snapshot_identifier = %[1]q
+  shared_accounts                = [%[2]s]
+}
+`, rName, sharedAccount))
+}
+
+func testAccClusterSnapshotConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_docdb_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier   = %[1]q
+  db_subnet_group_name = aws_docdb_subnet_group.test.name
+  master_password      = "avoid-plaintext-passwords"
+  master_username      = "tfacctest"
+  skip_final_snapshot  = true
+}
+
+resource "aws_docdb_cluster_snapshot" "test" {
+  db_cluster_identifier          = aws_docdb_cluster.test.id
+  db_cluster_snapshot_identifier = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccClusterSnapshotConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_docdb_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier   = %[1]q
+  db_subnet_group_name = aws_docdb_subnet_group.test.name
+  master_password      = "avoid-plaintext-passwords"
+  master_username      = "tfacctest"
+  skip_final_snapshot  = true
+}
+
+resource "aws_docdb_cluster_snapshot" "test" {
+  db_cluster_identifier          = aws_docdb_cluster.test.id
+  db_cluster_snapshot_identifier = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccClusterSnapshotConfig_crossRegionCopy(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+resource "aws_docdb_cluster" "source" {
+  provider = "awsalternate"
+
+  cluster_identifier  = %[1]q
+  master_password     = "avoid-plaintext-passwords"
+  master_username     = "tfacctest"
+  skip_final_snapshot = true
+}
+
+resource "aws_docdb_cluster_snapshot" "source" {
+  provider = "awsalternate"
+
+  db_cluster_identifier          = aws_docdb_cluster.source.id
+  db_cluster_snapshot_identifier = %[1]q
+}
+
+resource "aws_docdb_cluster_snapshot" "test" {
+  db_cluster_snapshot_identifier = "%[1]s-copy"
+  source_db_cluster_snapshot_arn = aws_docdb_cluster_snapshot.source.db_cluster_snapshot_arn
+}
+`, rName))
+}
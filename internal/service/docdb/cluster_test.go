@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -61,6 +63,7 @@ func TestAccDocDBCluster_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "cluster_resource_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "db_cluster_parameter_group_name"),
 					resource.TestCheckResourceAttr(resourceName, "db_subnet_group_name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "delete_instances_on_destroy", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDeletionProtection, acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "enabled_cloudwatch_logs_exports.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "enabled_cloudwatch_logs_exports.0", "audit"),
@@ -348,6 +351,33 @@ func TestAccDocDBCluster_updateCloudWatchLogsExports(t *testing.T) {
 	})
 }
 
+func TestAccDocDBCluster_reorderCloudWatchLogsExports(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enabled_cloudwatch_logs_exports.#", "2"),
+				),
+			},
+			{
+				Config:   testAccClusterConfig_cloudWatchLogsExportsReordered(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccDocDBCluster_kmsKey(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.DBCluster
@@ -383,6 +413,37 @@ func TestAccDocDBCluster_kmsKey(t *testing.T) {
 	})
 }
 
+func TestAccDocDBCluster_kmsKeyAlias(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_kmsKeyAlias(rName, "aws_kms_alias.test.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyID, "aws_kms_key.test", names.AttrARN),
+				),
+			},
+			{
+				Config:   testAccClusterConfig_kmsKeyAlias(rName, "aws_kms_alias.test.name"),
+				PlanOnly: true,
+			},
+			{
+				Config:   testAccClusterConfig_kmsKeyAlias(rName, "aws_kms_alias.test.arn"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccDocDBCluster_encrypted(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.DBCluster
@@ -464,6 +525,42 @@ func TestAccDocDBCluster_backupsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccDocDBCluster_updateMasterPassword(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbCluster1, dbCluster2 awstypes.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_masterPassword(rName, "avoid-plaintext-passwords"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster1),
+					resource.TestCheckResourceAttr(resourceName, "master_password", "avoid-plaintext-passwords"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_masterPassword(rName, "rotated-plaintext-passwords"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster2),
+					testAccCheckClusterNotRecreated(&dbCluster1, &dbCluster2),
+					resource.TestCheckResourceAttr(resourceName, "master_password", "rotated-plaintext-passwords"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDocDBCluster_pointInTimeRestore(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dbCluster awstypes.DBCluster
@@ -536,10 +633,161 @@ func TestAccDocDBCluster_port(t *testing.T) {
 				Config: testAccClusterConfig_port(rName, 2345),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName, &dbCluster2),
-					testAccCheckClusterRecreated(&dbCluster1, &dbCluster2),
+					testAccCheckClusterNotRecreated(&dbCluster1, &dbCluster2),
 					resource.TestCheckResourceAttr(resourceName, names.AttrPort, "2345"),
 				),
 			},
+			{
+				// Refresh the instances to pick up the port applied by the rolling reboot.
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_docdb_cluster_instance.test.0", names.AttrPort, "2345"),
+					resource.TestCheckResourceAttr("aws_docdb_cluster_instance.test.1", names.AttrPort, "2345"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDocDBCluster_deleteInstancesOnDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbCluster awstypes.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdb_cluster.test"
+	instanceID := rName + "-unmanaged"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckClusterDestroy(ctx),
+			testAccCheckClusterInstanceIDDestroyed(ctx, instanceID),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_deleteInstancesOnDestroy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "delete_instances_on_destroy", acctest.CtTrue),
+					// The instance is not managed by Terraform, so the cluster can only be destroyed if it is deleted first.
+					testAccCheckClusterCreateInstance(ctx, &dbCluster, instanceID, "data.aws_docdb_orderable_db_instance.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDocDBCluster_writerInstance(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbCluster1, dbCluster2 awstypes.DBCluster
+	var writer string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_writerInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster1),
+				),
+			},
+			{
+				// The cluster is read before its instances are created.
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cluster_members.#", "2"),
+					resource.TestMatchResourceAttr(resourceName, "writer_instance", regexache.MustCompile(fmt.Sprintf(`^%s-[01]$`, rName))),
+					testAccCheckClusterWriterInstance(resourceName, &writer),
+					testAccCheckClusterFailover(ctx, resourceName),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster2),
+					testAccCheckClusterNotRecreated(&dbCluster1, &dbCluster2),
+					resource.TestCheckResourceAttr(resourceName, "cluster_members.#", "2"),
+					func(s *terraform.State) error {
+						if got := s.RootModule().Resources[resourceName].Primary.Attributes["writer_instance"]; got == writer {
+							return fmt.Errorf("writer_instance = %q after failover, want a different instance", got)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				Config:   testAccClusterConfig_writerInstance(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccDocDBCluster_dbClusterParameterGroupName(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbCluster1, dbCluster2 awstypes.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_dbClusterParameterGroupName(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster1),
+					resource.TestCheckResourceAttrPair(resourceName, "db_cluster_parameter_group_name", "aws_docdb_cluster_parameter_group.test.0", names.AttrName),
+				),
+			},
+			{
+				Config: testAccClusterConfig_dbClusterParameterGroupName(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster2),
+					testAccCheckClusterNotRecreated(&dbCluster1, &dbCluster2),
+					resource.TestCheckResourceAttrPair(resourceName, "db_cluster_parameter_group_name", "aws_docdb_cluster_parameter_group.test.1", names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDocDBCluster_dbClusterParameterGroupFamilyMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbCluster awstypes.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The parameter group must exist when the cluster is planned.
+				Config: testAccClusterConfig_baseParameterGroupFamily(rName, "docdb4.0"),
+			},
+			{
+				Config:      testAccClusterConfig_dbClusterParameterGroupFamily(rName, "docdb4.0", "5.0.0"),
+				ExpectError: regexache.MustCompile(`family "docdb4.0" does not match engine_version "5.0.0" family "docdb5.0"`),
+			},
+			{
+				Config: testAccClusterConfig_dbClusterParameterGroupFamily(rName, "docdb4.0", "4.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttrPair(resourceName, "db_cluster_parameter_group_name", "aws_docdb_cluster_parameter_group.test", names.AttrName),
+				),
+			},
 		},
 	})
 }
@@ -864,13 +1112,323 @@ func TestAccDocDBCluster_updateEngineMajorVersion(t *testing.T) {
 				},
 			},
 			{
-				Config: testAccClusterConfig_engineVersion(rName, "5.0.0"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
-					resource.TestCheckResourceAttr(resourceName, "cluster_members.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "db_cluster_parameter_group_name", "default.docdb5.0"),
-					resource.TestCheckResourceAttr(resourceName, names.AttrEngineVersion, "5.0.0"),
-				),
+				Config: testAccClusterConfig_engineVersion(rName, "5.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "cluster_members.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "db_cluster_parameter_group_name", "default.docdb5.0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEngineVersion, "5.0.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDocDBCluster_updateEngineMajorVersionWithInstances(t *testing.T) {
+	// https://docs.aws.amazon.com/documentdb/latest/developerguide/docdb-mvu.html.
+	acctest.Skip(t, "Amazon DocumentDB has identified an issue and is temporarily disallowing major version upgrades (MVU) in all regions.")
+
+	ctx := acctest.Context(t)
+	var dbCluster awstypes.DBCluster
+	var dbInstance awstypes.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdb_cluster.test"
+	instanceResourceName1 := "aws_docdb_cluster_instance.test.0"
+	instanceResourceName2 := "aws_docdb_cluster_instance.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_engineVersionWithInstances(rName, "4.0.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "cluster_members.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEngineVersion, "4.0.0"),
+					resource.TestCheckResourceAttr(instanceResourceName1, names.AttrEngineVersion, "4.0.0"),
+					resource.TestCheckResourceAttr(instanceResourceName2, names.AttrEngineVersion, "4.0.0"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_engineVersionWithInstances(rName, "5.0.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "cluster_members.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEngineVersion, "5.0.0"),
+				),
+			},
+			{
+				// The instances have no planned changes, so their upgraded engine version is only read on refresh.
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, instanceResourceName1, &dbInstance),
+					resource.TestCheckResourceAttr(instanceResourceName1, names.AttrEngineVersion, "5.0.0"),
+					testAccCheckClusterInstanceExists(ctx, instanceResourceName2, &dbInstance),
+					resource.TestCheckResourceAttr(instanceResourceName2, names.AttrEngineVersion, "5.0.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDocDBCluster_updateEngineMajorVersionNotAllowed(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbCluster awstypes.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_engineVersionNoMajorVersionUpgrade(rName, "4.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEngineVersion, "4.0.0"),
+				),
+			},
+			{
+				Config:      testAccClusterConfig_engineVersionNoMajorVersionUpgrade(rName, "5.0.0"),
+				ExpectError: regexache.MustCompile(`is a major version upgrade, set allow_major_version_upgrade to true`),
+			},
+		},
+	})
+}
+
+func TestIsMajorVersionUpgrade(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		o, n     string
+		expected bool
+	}{
+		"same version": {
+			o:        "5.0.0",
+			n:        "5.0.0",
+			expected: false,
+		},
+		"minor version": {
+			o:        "4.0.0",
+			n:        "4.1.0",
+			expected: false,
+		},
+		"major version": {
+			o:        "4.0.0",
+			n:        "5.0.0",
+			expected: true,
+		},
+		"major version downgrade": {
+			o:        "5.0.0",
+			n:        "3.6.0",
+			expected: true,
+		},
+		"no old version": {
+			n:        "5.0.0",
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfdocdb.IsMajorVersionUpgrade(testCase.o, testCase.n), testCase.expected; got != want {
+				t.Errorf("IsMajorVersionUpgrade(%q, %q) = %t, want %t", testCase.o, testCase.n, got, want)
+			}
+		})
+	}
+}
+
+func TestClusterWriterInstance(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiObject *awstypes.DBCluster
+		expected  string
+	}{
+		"nil": {},
+		"no members": {
+			apiObject: &awstypes.DBCluster{},
+		},
+		"writer": {
+			apiObject: &awstypes.DBCluster{
+				DBClusterMembers: []awstypes.DBClusterMember{
+					{DBInstanceIdentifier: aws.String("reader"), IsClusterWriter: aws.Bool(false)},
+					{DBInstanceIdentifier: aws.String("writer"), IsClusterWriter: aws.Bool(true)},
+				},
+			},
+			expected: "writer",
+		},
+		"readers only": {
+			apiObject: &awstypes.DBCluster{
+				DBClusterMembers: []awstypes.DBClusterMember{
+					{DBInstanceIdentifier: aws.String("reader"), IsClusterWriter: aws.Bool(false)},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfdocdb.ClusterWriterInstance(testCase.apiObject), testCase.expected; got != want {
+				t.Errorf("ClusterWriterInstance() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestClusterPromotionTierWarning(t *testing.T) {
+	t.Parallel()
+
+	member := func(id string, tier int32) awstypes.DBClusterMember {
+		return awstypes.DBClusterMember{DBInstanceIdentifier: aws.String(id), PromotionTier: aws.Int32(tier)}
+	}
+
+	testCases := map[string]struct {
+		apiObject *awstypes.DBCluster
+		expected  string
+	}{
+		"nil": {},
+		"single instance": {
+			apiObject: &awstypes.DBCluster{
+				DBClusterMembers: []awstypes.DBClusterMember{member("a", 5)},
+			},
+		},
+		"same tier": {
+			apiObject: &awstypes.DBCluster{
+				DBClusterMembers: []awstypes.DBClusterMember{member("a", 0), member("b", 0), member("c", 0)},
+			},
+		},
+		"single tier 0": {
+			apiObject: &awstypes.DBCluster{
+				DBClusterMembers: []awstypes.DBClusterMember{member("a", 1), member("b", 0), member("c", 1)},
+			},
+		},
+		"shared tier 0": {
+			apiObject: &awstypes.DBCluster{
+				DBClusterMembers: []awstypes.DBClusterMember{member("c", 0), member("b", 1), member("a", 0)},
+			},
+			expected: `instances ["a" "c"] share the lowest promotion tier (0)`,
+		},
+		"no tier 0": {
+			apiObject: &awstypes.DBCluster{
+				DBClusterMembers: []awstypes.DBClusterMember{member("a", 2), member("b", 1)},
+			},
+			expected: "has no instance with promotion tier 0 (lowest promotion tier is 1)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfdocdb.ClusterPromotionTierWarning(testCase.apiObject), testCase.expected; got != want {
+				t.Errorf("ClusterPromotionTierWarning() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestWindowsOverlap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		backup, maintenance string
+		expected            bool
+		expectError         bool
+	}{
+		"disjoint": {
+			backup:      "07:00-09:00",
+			maintenance: "tue:04:00-tue:04:30",
+			expected:    false,
+		},
+		"overlap": {
+			backup:      "04:00-05:00",
+			maintenance: "wed:04:30-wed:05:30",
+			expected:    true,
+		},
+		"adjacent": {
+			backup:      "03:00-04:00",
+			maintenance: "sun:04:00-sun:04:30",
+			expected:    false,
+		},
+		"uppercase day": {
+			backup:      "04:00-05:00",
+			maintenance: "Mon:04:30-Mon:05:30",
+			expected:    true,
+		},
+		"backup wraps midnight": {
+			backup:      "23:30-00:30",
+			maintenance: "fri:00:00-fri:00:30",
+			expected:    true,
+		},
+		"backup wraps into monday": {
+			backup:      "23:30-00:30",
+			maintenance: "mon:00:00-mon:00:20",
+			expected:    true,
+		},
+		"maintenance wraps week": {
+			backup:      "00:00-00:30",
+			maintenance: "sun:23:45-mon:00:15",
+			expected:    true,
+		},
+		"invalid backup": {
+			backup:      "07:00",
+			maintenance: "tue:04:00-tue:04:30",
+			expectError: true,
+		},
+		"invalid maintenance day": {
+			backup:      "07:00-09:00",
+			maintenance: "xyz:04:00-xyz:04:30",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfdocdb.WindowsOverlap(testCase.backup, testCase.maintenance)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("WindowsOverlap(%q, %q) = %t, want %t", testCase.backup, testCase.maintenance, got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAccDocDBCluster_backupWindowOverlapsMaintenanceWindow(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_backupWindows(rName, "04:00-05:00", "tue:04:30-tue:05:00"),
+				ExpectError: regexache.MustCompile(`must not overlap preferred_maintenance_window`),
 			},
 		},
 	})
@@ -1011,10 +1569,120 @@ func testAccCheckClusterDestroyWithFinalSnapshot(ctx context.Context) resource.T
 	}
 }
 
-func testAccCheckClusterRecreated(i, j *awstypes.DBCluster) resource.TestCheckFunc {
+func testAccCheckClusterWriterInstance(n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		*v = rs.Primary.Attributes["writer_instance"]
+
+		return nil
+	}
+}
+
+// testAccCheckClusterFailover fails the cluster over to one of its readers and waits for the new writer.
+func testAccCheckClusterFailover(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBClient(ctx)
+
+		dbc, err := tfdocdb.FindDBClusterByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var target string
+		for _, v := range dbc.DBClusterMembers {
+			if !aws.ToBool(v.IsClusterWriter) {
+				target = aws.ToString(v.DBInstanceIdentifier)
+				break
+			}
+		}
+
+		if target == "" {
+			return fmt.Errorf("DocumentDB Cluster (%s) has no reader to fail over to", rs.Primary.ID)
+		}
+
+		input := &docdb.FailoverDBClusterInput{
+			DBClusterIdentifier:        aws.String(rs.Primary.ID),
+			TargetDBInstanceIdentifier: aws.String(target),
+		}
+
+		if _, err := conn.FailoverDBCluster(ctx, input); err != nil {
+			return fmt.Errorf("failing over DocumentDB Cluster (%s): %w", rs.Primary.ID, err)
+		}
+
+		_, err = tfresource.RetryUntilEqual(ctx, 30*time.Minute, target, func() (string, error) {
+			dbc, err := tfdocdb.FindDBClusterByID(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return "", err
+			}
+
+			return tfdocdb.ClusterWriterInstance(dbc), nil
+		})
+
+		return err
+	}
+}
+
+func testAccCheckClusterCreateInstance(ctx context.Context, v *awstypes.DBCluster, id, orderableDataSourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[orderableDataSourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", orderableDataSourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBClient(ctx)
+
+		input := &docdb.CreateDBInstanceInput{
+			DBClusterIdentifier:  v.DBClusterIdentifier,
+			DBInstanceClass:      aws.String(rs.Primary.Attributes["instance_class"]),
+			DBInstanceIdentifier: aws.String(id),
+			Engine:               v.Engine,
+		}
+
+		if _, err := conn.CreateDBInstance(ctx, input); err != nil {
+			return fmt.Errorf("creating DocumentDB Cluster Instance (%s): %w", id, err)
+		}
+
+		if _, err := tfdocdb.WaitDBInstanceAvailable(ctx, conn, id, 90*time.Minute); err != nil {
+			return fmt.Errorf("waiting for DocumentDB Cluster Instance (%s) create: %w", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckClusterInstanceIDDestroyed(ctx context.Context, id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBClient(ctx)
+
+		_, err := tfdocdb.FindDBInstanceByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DocumentDB Cluster Instance %s still exists", id)
+	}
+}
+
+func testAccCheckClusterNotRecreated(i, j *awstypes.DBCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if aws.ToTime(i.ClusterCreateTime).Equal(aws.ToTime(j.ClusterCreateTime)) {
-			return errors.New("DocumentDB Cluster was not recreated")
+		if !aws.ToTime(i.ClusterCreateTime).Equal(aws.ToTime(j.ClusterCreateTime)) {
+			return errors.New("DocumentDB Cluster was recreated")
 		}
 
 		return nil
@@ -1044,6 +1712,31 @@ resource "aws_docdb_cluster" "test" {
 `, rName))
 }
 
+func testAccClusterConfig_masterPassword(rName, password string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier  = %[1]q
+  apply_immediately   = true
+  master_password     = %[2]q
+  master_username     = "tfacctest"
+  skip_final_snapshot = true
+}
+`, rName, password)
+}
+
+func testAccClusterConfig_backupWindows(rName, backupWindow, maintenanceWindow string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier           = %[1]q
+  master_password              = "avoid-plaintext-passwords"
+  master_username              = "tfacctest"
+  preferred_backup_window      = %[2]q
+  preferred_maintenance_window = %[3]q
+  skip_final_snapshot          = true
+}
+`, rName, backupWindow, maintenanceWindow)
+}
+
 func testAccClusterConfig_identifierGenerated() string {
 	return `
 resource "aws_docdb_cluster" "test" {
@@ -1148,6 +1841,29 @@ resource "aws_docdb_cluster" "test" {
 `, rName))
 }
 
+func testAccClusterConfig_cloudWatchLogsExportsReordered(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier = %[1]q
+
+  availability_zones = [
+    data.aws_availability_zones.available.names[0],
+    data.aws_availability_zones.available.names[1],
+    data.aws_availability_zones.available.names[2]
+  ]
+
+  master_password     = "avoid-plaintext-passwords"
+  master_username     = "tfacctest"
+  skip_final_snapshot = true
+
+  enabled_cloudwatch_logs_exports = [
+    "profiler",
+    "audit",
+  ]
+}
+`, rName))
+}
+
 func testAccClusterConfig_kmsKey(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -1189,6 +1905,29 @@ resource "aws_docdb_cluster" "test" {
 `, rName))
 }
 
+func testAccClusterConfig_kmsKeyAlias(rName, kmsKeyID string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_alias" "test" {
+  name          = "alias/%[1]s"
+  target_key_id = aws_kms_key.test.key_id
+}
+
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier  = %[1]q
+  master_password     = "avoid-plaintext-passwords"
+  master_username     = "tfacctest"
+  storage_encrypted   = true
+  kms_key_id          = %[2]s
+  skip_final_snapshot = true
+}
+`, rName, kmsKeyID)
+}
+
 func testAccClusterConfig_encrypted(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_docdb_cluster" "test" {
@@ -1251,6 +1990,66 @@ resource "aws_docdb_cluster" "test" {
 `, rName))
 }
 
+func testAccClusterConfig_baseParameterGroupFamily(rName, family string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster_parameter_group" "test" {
+  name   = %[1]q
+  family = %[2]q
+}
+`, rName, family)
+}
+
+func testAccClusterConfig_dbClusterParameterGroupFamily(rName, family, engineVersion string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_baseParameterGroupFamily(rName, family), fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier              = %[1]q
+  engine_version                  = %[2]q
+  db_cluster_parameter_group_name = aws_docdb_cluster_parameter_group.test.name
+  master_password                 = "avoid-plaintext-passwords"
+  master_username                 = "tfacctest"
+  skip_final_snapshot             = true
+}
+`, rName, engineVersion))
+}
+
+func testAccClusterConfig_dbClusterParameterGroupName(rName string, index int) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster_parameter_group" "test" {
+  count = 2
+
+  name   = "%[1]s-${count.index}"
+  family = "docdb5.0"
+
+  parameter {
+    name  = "tls"
+    value = count.index == 0 ? "enabled" : "disabled"
+  }
+}
+
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier              = %[1]q
+  engine_version                  = "5.0.0"
+  db_cluster_parameter_group_name = aws_docdb_cluster_parameter_group.test[%[2]d].name
+  master_password                 = "avoid-plaintext-passwords"
+  master_username                 = "tfacctest"
+  skip_final_snapshot             = true
+  apply_immediately               = true
+}
+
+data "aws_docdb_orderable_db_instance" "test" {
+  engine                     = aws_docdb_cluster.test.engine
+  engine_version             = aws_docdb_cluster.test.engine_version
+  preferred_instance_classes = ["db.t3.medium", "db.4tg.medium", "db.r5.large", "db.r6g.large"]
+}
+
+resource "aws_docdb_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_docdb_cluster.test.id
+  instance_class     = data.aws_docdb_orderable_db_instance.test.instance_class
+}
+`, rName, index)
+}
+
 func testAccClusterConfig_port(rName string, port int) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_docdb_cluster" "test" {
@@ -1266,10 +2065,65 @@ resource "aws_docdb_cluster" "test" {
   master_username     = "tfacctest"
   port                = %[2]d
   skip_final_snapshot = true
+  apply_immediately   = true
+}
+
+data "aws_docdb_orderable_db_instance" "test" {
+  engine                     = aws_docdb_cluster.test.engine
+  preferred_instance_classes = ["db.t3.medium", "db.4tg.medium", "db.r5.large", "db.r6g.large"]
+}
+
+resource "aws_docdb_cluster_instance" "test" {
+  count = 2
+
+  identifier         = "%[1]s-${count.index}"
+  cluster_identifier = aws_docdb_cluster.test.id
+  instance_class     = data.aws_docdb_orderable_db_instance.test.instance_class
 }
 `, rName, port))
 }
 
+func testAccClusterConfig_deleteInstancesOnDestroy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier          = %[1]q
+  master_password             = "avoid-plaintext-passwords"
+  master_username             = "tfacctest"
+  skip_final_snapshot         = true
+  delete_instances_on_destroy = true
+}
+
+data "aws_docdb_orderable_db_instance" "test" {
+  engine                     = aws_docdb_cluster.test.engine
+  preferred_instance_classes = ["db.t3.medium", "db.4tg.medium", "db.r5.large", "db.r6g.large"]
+}
+`, rName)
+}
+
+func testAccClusterConfig_writerInstance(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier  = %[1]q
+  master_password     = "avoid-plaintext-passwords"
+  master_username     = "tfacctest"
+  skip_final_snapshot = true
+}
+
+data "aws_docdb_orderable_db_instance" "test" {
+  engine                     = aws_docdb_cluster.test.engine
+  preferred_instance_classes = ["db.t3.medium", "db.4tg.medium", "db.r5.large", "db.r6g.large"]
+}
+
+resource "aws_docdb_cluster_instance" "test" {
+  count = 2
+
+  identifier         = "%[1]s-${count.index}"
+  cluster_identifier = aws_docdb_cluster.test.id
+  instance_class     = data.aws_docdb_orderable_db_instance.test.instance_class
+}
+`, rName)
+}
+
 func testAccClusterConfig_baseForPITR(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_docdb_cluster" "test" {
@@ -1480,6 +2334,46 @@ resource "aws_docdb_cluster_instance" "test" {
 `, rName, engineVersion)
 }
 
+func testAccClusterConfig_engineVersionWithInstances(rName, engineVersion string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier          = %[1]q
+  engine_version              = %[2]q
+  master_password             = "avoid-plaintext-passwords"
+  master_username             = "tfacctest"
+  skip_final_snapshot         = true
+  apply_immediately           = true
+  allow_major_version_upgrade = true
+}
+
+data "aws_docdb_orderable_db_instance" "test" {
+  engine                     = aws_docdb_cluster.test.engine
+  preferred_instance_classes = ["db.t3.medium", "db.4tg.medium", "db.r5.large", "db.r6g.large"]
+}
+
+resource "aws_docdb_cluster_instance" "test" {
+  count = 2
+
+  identifier         = "%[1]s-${count.index}"
+  cluster_identifier = aws_docdb_cluster.test.id
+  instance_class     = data.aws_docdb_orderable_db_instance.test.instance_class
+}
+`, rName, engineVersion)
+}
+
+func testAccClusterConfig_engineVersionNoMajorVersionUpgrade(rName, engineVersion string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier  = %[1]q
+  engine_version      = %[2]q
+  master_password     = "avoid-plaintext-passwords"
+  master_username     = "tfacctest"
+  skip_final_snapshot = true
+  apply_immediately   = true
+}
+`, rName, engineVersion)
+}
+
 func testAccClusterConfig_storageType(rName, storageType string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_docdb_cluster" "test" {
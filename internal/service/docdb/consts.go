@@ -37,18 +37,55 @@ const (
 	clusterStatusUpgrading                  = "upgrading"
 )
 
+const (
+	dbClusterParameterGroupStatusApplying      = "applying"
+	dbClusterParameterGroupStatusInSync        = "in-sync"
+	dbClusterParameterGroupStatusPendingReboot = "pending-reboot"
+)
+
 const (
 	clusterSnapshotStatusAvailable = "available"
 	clusterSnapshotStatusCreating  = "creating"
 )
 
+const (
+	clusterSnapshotAttributeNameRestore = "restore"
+)
+
+const (
+	clusterSnapshotTypeAutomated = "automated"
+	clusterSnapshotTypeManual    = "manual"
+)
+
 const (
 	eventSubscriptionStatusActive    = "active"
 	eventSubscriptionStatusCreating  = "creating"
 	eventSubscriptionStatusDeleting  = "deleting"
 	eventSubscriptionStatusModifying = "modifying"
+	// The subscription reports these while its SNS topic or topic policy has not yet propagated,
+	// and also when the topic or policy is misconfigured.
+	eventSubscriptionStatusNoPermission  = "no-permission"
+	eventSubscriptionStatusTopicNotExist = "topic-not-exist"
 )
 
+const (
+	eventSourceTypeDBCluster         = "db-cluster"
+	eventSourceTypeDBClusterSnapshot = "db-cluster-snapshot"
+	eventSourceTypeDBInstance        = "db-instance"
+	eventSourceTypeDBParameterGroup  = "db-parameter-group"
+	eventSourceTypeDBSecurityGroup   = "db-security-group"
+)
+
+func eventSourceType_Values() []string {
+	return []string{
+		eventSourceTypeDBCluster,
+		eventSourceTypeDBClusterSnapshot,
+		eventSourceTypeDBInstance,
+		eventSourceTypeDBParameterGroup,
+		eventSourceTypeDBSecurityGroup,
+	}
+}
+
 const (
 	globalClusterStatusAvailable = "available"
 	globalClusterStatusCreating  = "creating"
@@ -58,6 +95,10 @@ const (
 	globalClusterStatusUpgrading = "upgrading"
 )
 
+const (
+	parameterApplyTypeStatic = "static"
+)
+
 const (
 	storageTypeIOpt1    = "iopt1"
 	storageTypeStandard = "standard"
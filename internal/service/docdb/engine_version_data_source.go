@@ -5,6 +5,8 @@ package docdb
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/docdb"
@@ -14,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/semver"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -38,6 +41,10 @@ func dataSourceEngineVersion() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"latest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"parameter_group_family": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -49,6 +56,11 @@ func dataSourceEngineVersion() *schema.Resource {
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				ConflictsWith: []string{names.AttrVersion},
 			},
+			"supported_parameter_group_families": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"supports_log_exports_to_cloudwatch": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -86,36 +98,42 @@ func dataSourceEngineVersionRead(ctx context.Context, d *schema.ResourceData, me
 		input.DBParameterGroupFamily = aws.String(v.(string))
 	}
 
+	latest := d.Get("latest").(bool)
+
 	if v, ok := d.GetOk(names.AttrVersion); ok {
 		input.EngineVersion = aws.String(v.(string))
-	} else if _, ok := d.GetOk("preferred_versions"); !ok {
+	} else if _, ok := d.GetOk("preferred_versions"); !ok && !latest {
 		if _, ok := d.GetOk("parameter_group_family"); !ok {
 			input.DefaultOnly = aws.Bool(true)
 		}
 	}
 
 	var engineVersion *awstypes.DBEngineVersion
+	// All of the engine's versions, when the lookup has already fetched them.
+	var allEngineVersions []awstypes.DBEngineVersion
 	var err error
-	if preferredVersions := flex.ExpandStringValueList(d.Get("preferred_versions").([]interface{})); len(preferredVersions) > 0 {
+	if preferredVersions := flex.ExpandStringValueList(d.Get("preferred_versions").([]interface{})); len(preferredVersions) > 0 || latest {
 		var engineVersions []awstypes.DBEngineVersion
 
 		engineVersions, err = findEngineVersions(ctx, conn, input)
 
 		if err == nil {
-		PreferredVersionLoop:
-			// Return the first matching version.
-			for _, preferredVersion := range preferredVersions {
-				for _, v := range engineVersions {
-					if preferredVersion == aws.ToString(v.EngineVersion) {
-						ev := &v
-						engineVersion = ev
-						break PreferredVersionLoop
-					}
-				}
+			if input.DBParameterGroupFamily == nil {
+				allEngineVersions = engineVersions
+			}
+
+			if len(preferredVersions) > 0 {
+				// Prefix matching is opt-in so that existing configurations keep selecting the same version.
+				engineVersions = filterEngineVersionsByPreferredVersions(engineVersions, preferredVersions, latest)
 			}
 
-			if engineVersion == nil {
+			switch {
+			case len(engineVersions) == 0:
 				err = tfresource.NewEmptyResultError(input)
+			case latest:
+				engineVersion = latestEngineVersion(engineVersions)
+			default:
+				engineVersion = &engineVersions[0]
 			}
 		}
 	} else {
@@ -126,11 +144,18 @@ func dataSourceEngineVersionRead(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("DocumentDB Engine Version", err))
 	}
 
+	families, err := findParameterGroupFamiliesForUpgradeTargets(ctx, conn, engineVersion, allEngineVersions)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DocumentDB Engine Version (%s) parameter group families: %s", aws.ToString(engineVersion.EngineVersion), err)
+	}
+
 	d.SetId(aws.ToString(engineVersion.EngineVersion))
 	d.Set(names.AttrEngine, engineVersion.Engine)
 	d.Set("engine_description", engineVersion.DBEngineDescription)
 	d.Set("exportable_log_types", engineVersion.ExportableLogTypes)
 	d.Set("parameter_group_family", engineVersion.DBParameterGroupFamily)
+	d.Set("supported_parameter_group_families", families)
 	d.Set("supports_log_exports_to_cloudwatch", engineVersion.SupportsLogExportsToCloudwatchLogs)
 	d.Set("valid_upgrade_targets", tfslices.ApplyToAll(engineVersion.ValidUpgradeTarget, func(v awstypes.UpgradeTarget) string {
 		return aws.ToString(v.EngineVersion)
@@ -168,3 +193,74 @@ func findEngineVersions(ctx context.Context, conn *docdb.Client, input *docdb.De
 
 	return output, nil
 }
+
+// filterEngineVersionsByPreferredVersions returns the engine versions matching the first
+// preferred version that has any match. A preferred version matches an engine version
+// exactly or, if prefixMatch is set, as a version prefix, e.g. "5.0" matches "5.0.0".
+func filterEngineVersionsByPreferredVersions(engineVersions []awstypes.DBEngineVersion, preferredVersions []string, prefixMatch bool) []awstypes.DBEngineVersion {
+	for _, preferredVersion := range preferredVersions {
+		var matches []awstypes.DBEngineVersion
+
+		for _, v := range engineVersions {
+			if engineVersion := aws.ToString(v.EngineVersion); engineVersion == preferredVersion || (prefixMatch && strings.HasPrefix(engineVersion, preferredVersion+".")) {
+				matches = append(matches, v)
+			}
+		}
+
+		if len(matches) > 0 {
+			return matches
+		}
+	}
+
+	return nil
+}
+
+func latestEngineVersion(engineVersions []awstypes.DBEngineVersion) *awstypes.DBEngineVersion {
+	var latest *awstypes.DBEngineVersion
+
+	for i, v := range engineVersions {
+		if latest == nil || semver.LessThan(aws.ToString(latest.EngineVersion), aws.ToString(v.EngineVersion)) {
+			latest = &engineVersions[i]
+		}
+	}
+
+	return latest
+}
+
+// findParameterGroupFamiliesForUpgradeTargets returns the parameter group families of the
+// specified engine version and of each of its valid upgrade targets.
+// engineVersions, if set, lists all versions of the engine and saves a DescribeDBEngineVersions call.
+func findParameterGroupFamiliesForUpgradeTargets(ctx context.Context, conn *docdb.Client, engineVersion *awstypes.DBEngineVersion, engineVersions []awstypes.DBEngineVersion) ([]string, error) {
+	families := []string{aws.ToString(engineVersion.DBParameterGroupFamily)}
+
+	if len(engineVersion.ValidUpgradeTarget) == 0 {
+		return families, nil
+	}
+
+	if engineVersions == nil {
+		input := &docdb.DescribeDBEngineVersionsInput{
+			Engine: engineVersion.Engine,
+		}
+
+		var err error
+		engineVersions, err = findEngineVersions(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, target := range engineVersion.ValidUpgradeTarget {
+		for _, v := range engineVersions {
+			if aws.ToString(v.EngineVersion) != aws.ToString(target.EngineVersion) {
+				continue
+			}
+
+			if family := aws.ToString(v.DBParameterGroupFamily); !slices.Contains(families, family) {
+				families = append(families, family)
+			}
+		}
+	}
+
+	return families, nil
+}
@@ -6,14 +6,17 @@ package docdb_test
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/docdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdocdb "github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -35,8 +38,10 @@ func TestAccDocDBEngineVersionDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, names.AttrVersion, version),
 					resource.TestCheckResourceAttrSet(dataSourceName, "engine_description"),
 					resource.TestMatchResourceAttr(dataSourceName, "exportable_log_types.#", regexache.MustCompile(`^[1-9][0-9]*`)),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "exportable_log_types.*", "audit"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "exportable_log_types.*", "profiler"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "parameter_group_family"),
-					resource.TestCheckResourceAttrSet(dataSourceName, "supports_log_exports_to_cloudwatch"),
+					resource.TestCheckResourceAttr(dataSourceName, "supports_log_exports_to_cloudwatch", acctest.CtTrue),
 					resource.TestCheckResourceAttrSet(dataSourceName, "version_description"),
 				),
 			},
@@ -63,6 +68,27 @@ func TestAccDocDBEngineVersionDataSource_preferred(t *testing.T) {
 	})
 }
 
+func TestAccDocDBEngineVersionDataSource_preferredLatest(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_docdb_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccEngineVersionPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineVersionDataSourceConfig_preferredLatest(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrVersion, regexache.MustCompile(`^4\.0\.`)),
+					resource.TestCheckResourceAttr(dataSourceName, "parameter_group_family", "docdb4.0"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "supported_parameter_group_families.*", "docdb4.0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDocDBEngineVersionDataSource_defaultOnly(t *testing.T) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_docdb_engine_version.test"
@@ -119,8 +145,71 @@ data "aws_docdb_engine_version" "test" {
 `
 }
 
+func testAccEngineVersionDataSourceConfig_preferredLatest() string {
+	return `
+data "aws_docdb_engine_version" "test" {
+  preferred_versions = ["34.6", "4.0", "3.6"]
+  latest             = true
+}
+`
+}
+
 func testAccEngineVersionDataSourceConfig_defaultOnly() string {
 	return `
 data "aws_docdb_engine_version" "test" {}
 `
 }
+
+func TestFilterEngineVersionsByPreferredVersions(t *testing.T) {
+	t.Parallel()
+
+	engineVersions := []awstypes.DBEngineVersion{
+		{EngineVersion: aws.String("3.6.0")},
+		{EngineVersion: aws.String("4.0.0")},
+		{EngineVersion: aws.String("5.0.0")},
+	}
+
+	testCases := map[string]struct {
+		preferredVersions []string
+		prefixMatch       bool
+		expected          []string
+	}{
+		"exact": {
+			preferredVersions: []string{"6.0.0", "4.0.0", "3.6.0"},
+			expected:          []string{"4.0.0"},
+		},
+		"prefix without opt-in": {
+			preferredVersions: []string{"5.0", "3.6.0"},
+			expected:          []string{"3.6.0"},
+		},
+		"prefix with opt-in": {
+			preferredVersions: []string{"5.0", "3.6.0"},
+			prefixMatch:       true,
+			expected:          []string{"5.0.0"},
+		},
+		"partial component": {
+			preferredVersions: []string{"5.0.0", "5"},
+			prefixMatch:       true,
+			expected:          []string{"5.0.0"},
+		},
+		"no match": {
+			preferredVersions: []string{"5.1"},
+			prefixMatch:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, v := range tfdocdb.FilterEngineVersionsByPreferredVersions(engineVersions, testCase.preferredVersions, testCase.prefixMatch) {
+				got = append(got, aws.ToString(v.EngineVersion))
+			}
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("FilterEngineVersionsByPreferredVersions() = %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
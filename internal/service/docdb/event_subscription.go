@@ -5,20 +5,26 @@ package docdb
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/docdb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -86,20 +92,97 @@ func resourceEventSubscription() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 			"source_ids": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{names.AttrSourceType},
 			},
 			names.AttrSourceType: {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(eventSourceType_Values(), false),
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffEventCategories,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func customizeDiffEventCategories(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("event_categories") || !d.NewValueKnown(names.AttrSourceType) {
+		return nil
+	}
+
+	categories := flex.ExpandStringValueSet(d.Get("event_categories").(*schema.Set))
+	if len(categories) == 0 {
+		return nil
+	}
+
+	sourceType := d.Get(names.AttrSourceType).(string)
+	if sourceType != "" && !slices.Contains(eventSourceType_Values(), sourceType) {
+		// Reported by the attribute's ValidateFunc.
+		return nil
+	}
+
+	if !d.HasChanges("event_categories", names.AttrSourceType) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).DocDBClient(ctx)
+
+	input := &docdb.DescribeEventCategoriesInput{}
+	if sourceType != "" {
+		input.SourceType = aws.String(sourceType)
+	}
+
+	output, err := findEventCategoriesMaps(ctx, conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading DocumentDB event categories: %w", err)
 	}
+
+	return validateEventCategories(sourceType, categories, output)
+}
+
+// validateEventCategories returns an error if any of the event categories is not valid for the source type.
+// An empty source type subscribes to all source types, so categories valid for any source type are accepted.
+func validateEventCategories(sourceType string, categories []string, apiObjects []awstypes.EventCategoriesMap) error {
+	valid := make(map[string]struct{})
+	for _, v := range apiObjects {
+		if sourceType != "" && aws.ToString(v.SourceType) != sourceType {
+			continue
+		}
+
+		for _, category := range v.EventCategories {
+			valid[category] = struct{}{}
+		}
+	}
+
+	var invalid []string
+	for _, v := range categories {
+		if _, ok := valid[v]; !ok {
+			invalid = append(invalid, v)
+		}
+	}
+
+	if len(invalid) == 0 {
+		return nil
+	}
+
+	slices.Sort(invalid)
+	validList := tfmaps.Keys(valid)
+	slices.Sort(validList)
+
+	if sourceType == "" {
+		return fmt.Errorf("event_categories: invalid event categories %q, valid values are %q", invalid, validList)
+	}
+
+	return fmt.Errorf("event_categories: invalid event categories %q for source_type %q, valid values are %q", invalid, sourceType, validList)
 }
 
 func resourceEventSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -231,8 +314,13 @@ func resourceEventSubscriptionUpdate(ctx context.Context, d *schema.ResourceData
 					SubscriptionName: aws.String(d.Id()),
 				})
 
+				// The source may already have been deleted, e.g. by replacing it in the same apply.
+				if errs.IsA[*awstypes.SourceNotFoundFault](err) {
+					continue
+				}
+
 				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "removing DocumentDB Cluster Event Subscription (%s) source identifier: %s", d.Id(), err)
+					return sdkdiag.AppendErrorf(diags, "removing DocumentDB Cluster Event Subscription (%s) source identifier (%s): %s", d.Id(), v, err)
 				}
 			}
 		}
@@ -245,7 +333,7 @@ func resourceEventSubscriptionUpdate(ctx context.Context, d *schema.ResourceData
 				})
 
 				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "adding DocumentDB Cluster Event Subscription (%s) source identifier: %s", d.Id(), err)
+					return sdkdiag.AppendErrorf(diags, "adding DocumentDB Cluster Event Subscription (%s) source identifier (%s): %s", d.Id(), v, err)
 				}
 			}
 		}
@@ -337,6 +425,20 @@ func findEventSubscriptions(ctx context.Context, conn *docdb.Client, input *docd
 	return output, nil
 }
 
+func findEventCategoriesMaps(ctx context.Context, conn *docdb.Client, input *docdb.DescribeEventCategoriesInput) ([]awstypes.EventCategoriesMap, error) {
+	output, err := conn.DescribeEventCategories(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EventCategoriesMapList, nil
+}
+
 func statusEventSubscription(ctx context.Context, conn *docdb.Client, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findEventSubscriptionByName(ctx, conn, name)
@@ -366,7 +468,7 @@ func waitEventSubscriptionCreated(ctx context.Context, conn *docdb.Client, name
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*awstypes.EventSubscription); ok {
-		return output, err
+		return waitEventSubscriptionTopicPropagated(ctx, conn, name, output, err)
 	}
 
 	return nil, err
@@ -384,6 +486,36 @@ func waitEventSubscriptionUpdated(ctx context.Context, conn *docdb.Client, name
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
+	if output, ok := outputRaw.(*awstypes.EventSubscription); ok {
+		return waitEventSubscriptionTopicPropagated(ctx, conn, name, output, err)
+	}
+
+	return nil, err
+}
+
+// waitEventSubscriptionTopicPropagated gives a subscription that reports a missing SNS topic or topic policy
+// a short grace period to become active, as a newly created topic or policy may not have propagated yet.
+// Any other result of the preceding wait is returned unchanged.
+func waitEventSubscriptionTopicPropagated(ctx context.Context, conn *docdb.Client, name string, output *awstypes.EventSubscription, err error) (*awstypes.EventSubscription, error) {
+	var unexpectedStateErr *retry.UnexpectedStateError
+	if !errors.As(err, &unexpectedStateErr) {
+		return output, err
+	}
+
+	if state := unexpectedStateErr.State; state != eventSubscriptionStatusNoPermission && state != eventSubscriptionStatusTopicNotExist {
+		return output, err
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{eventSubscriptionStatusNoPermission, eventSubscriptionStatusTopicNotExist},
+		Target:     []string{eventSubscriptionStatusActive},
+		Refresh:    statusEventSubscription(ctx, conn, name),
+		Timeout:    propagationTimeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
 	if output, ok := outputRaw.(*awstypes.EventSubscription); ok {
 		return output, err
 	}
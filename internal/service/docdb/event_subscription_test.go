@@ -6,12 +6,16 @@ package docdb_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -152,6 +156,96 @@ func TestAccDocDBEventSubscription_enabled(t *testing.T) {
 	})
 }
 
+func TestAccDocDBEventSubscription_enabledToggle(t *testing.T) {
+	ctx := acctest.Context(t)
+	var eventSubscription1, eventSubscription2, eventSubscription3 awstypes.EventSubscription
+	resourceName := "aws_docdb_event_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventSubscriptionConfig_enabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventSubscriptionExists(ctx, resourceName, &eventSubscription1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccEventSubscriptionConfig_enabled(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventSubscriptionExists(ctx, resourceName, &eventSubscription2),
+					testAccCheckEventSubscriptionNotRecreated(&eventSubscription1, &eventSubscription2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccEventSubscriptionConfig_enabled(rName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventSubscriptionExists(ctx, resourceName, &eventSubscription3),
+					testAccCheckEventSubscriptionNotRecreated(&eventSubscription1, &eventSubscription3),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDocDBEventSubscription_sourceIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var eventSubscription1, eventSubscription2 awstypes.EventSubscription
+	resourceName := "aws_docdb_event_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventSubscriptionConfig_sourceIDs(rName, 0, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventSubscriptionExists(ctx, resourceName, &eventSubscription1),
+					resource.TestCheckResourceAttr(resourceName, "source_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "source_ids.*", "aws_docdb_cluster.test.0", names.AttrID),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "source_ids.*", "aws_docdb_cluster.test.1", names.AttrID),
+				),
+			},
+			{
+				// Add one source and remove another in a single update.
+				Config: testAccEventSubscriptionConfig_sourceIDs(rName, 1, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventSubscriptionExists(ctx, resourceName, &eventSubscription2),
+					testAccCheckEventSubscriptionNotRecreated(&eventSubscription1, &eventSubscription2),
+					resource.TestCheckResourceAttr(resourceName, "source_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "source_ids.*", "aws_docdb_cluster.test.1", names.AttrID),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "source_ids.*", "aws_docdb_cluster.test.2", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDocDBEventSubscription_eventCategories(t *testing.T) {
 	ctx := acctest.Context(t)
 	var eventSubscription awstypes.EventSubscription
@@ -191,6 +285,85 @@ func TestAccDocDBEventSubscription_eventCategories(t *testing.T) {
 	})
 }
 
+func TestAccDocDBEventSubscription_eventCategoriesValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEventSubscriptionConfig_categories2(rName, "creation", "not-a-category"),
+				ExpectError: regexache.MustCompile(`invalid event categories \["not-a-category"\] for source_type "db-cluster"`),
+			},
+		},
+	})
+}
+
+func TestValidateEventCategories(t *testing.T) {
+	t.Parallel()
+
+	apiObjects := []awstypes.EventCategoriesMap{
+		{
+			EventCategories: []string{"creation", "deletion", "failover", "maintenance"},
+			SourceType:      aws.String("db-cluster"),
+		},
+		{
+			EventCategories: []string{"backup", "creation", "notification"},
+			SourceType:      aws.String("db-cluster-snapshot"),
+		},
+	}
+
+	testCases := map[string]struct {
+		sourceType    string
+		categories    []string
+		expectedError *regexp.Regexp
+	}{
+		"valid for source type": {
+			sourceType: "db-cluster",
+			categories: []string{"creation", "failover"},
+		},
+		"invalid for source type": {
+			sourceType:    "db-cluster",
+			categories:    []string{"creation", "backup"},
+			expectedError: regexache.MustCompile(`invalid event categories \["backup"\] for source_type "db-cluster"`),
+		},
+		"any source type": {
+			categories: []string{"backup", "failover"},
+		},
+		"invalid for any source type": {
+			categories:    []string{"backup", "unknown"},
+			expectedError: regexache.MustCompile(`invalid event categories \["unknown"\], valid values are`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfdocdb.ValidateEventCategories(testCase.sourceType, testCase.categories, apiObjects)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccDocDBEventSubscription_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var eventSubscription awstypes.EventSubscription
@@ -288,6 +461,16 @@ func testAccCheckEventSubscriptionExists(ctx context.Context, n string, eventSub
 	}
 }
 
+func testAccCheckEventSubscriptionNotRecreated(before, after *awstypes.EventSubscription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.SubscriptionCreationTime), aws.ToString(after.SubscriptionCreationTime); before != after {
+			return fmt.Errorf("DocumentDB Event Subscription was recreated: creation time %s != %s", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccEventSubscriptionBaseConfig(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_docdb_cluster" "test" {
@@ -324,6 +507,30 @@ resource "aws_docdb_event_subscription" "test" {
 `, rName, enabled))
 }
 
+func testAccEventSubscriptionConfig_sourceIDs(rName string, index1, index2 int) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  count = 3
+
+  cluster_identifier  = "%[1]s-${count.index}"
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  skip_final_snapshot = true
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_docdb_event_subscription" "test" {
+  name          = %[1]q
+  source_type   = "db-cluster"
+  source_ids    = [aws_docdb_cluster.test[%[2]d].id, aws_docdb_cluster.test[%[3]d].id]
+  sns_topic_arn = aws_sns_topic.test.arn
+}
+`, rName, index1, index2)
+}
+
 func testAccEventSubscriptionConfig_nameGenerated(rName string) string {
 	return acctest.ConfigCompose(
 		testAccEventSubscriptionBaseConfig(rName),
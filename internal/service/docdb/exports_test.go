@@ -19,4 +19,15 @@ var (
 	FindDBInstanceByID                = findDBInstanceByID
 	FindEventSubscriptionByName       = findEventSubscriptionByName
 	FindGlobalClusterByID             = findGlobalClusterByID
+
+	ClusterPromotionTierWarning             = clusterPromotionTierWarning
+	ClusterWriterInstance                   = clusterWriterInstance
+	FilterEngineVersionsByPreferredVersions = filterEngineVersionsByPreferredVersions
+	IsMajorVersionUpgrade                   = isMajorVersionUpgrade
+	ModifyClusterParameterGroupParameters   = modifyClusterParameterGroupParameters
+	ValidateAvailabilityZoneInSubnetGroup   = validateAvailabilityZoneInSubnetGroup
+	ValidateEventCategories                 = validateEventCategories
+	ValidateClusterParameterApplyMethods    = validateClusterParameterApplyMethods
+	WaitDBInstanceAvailable                 = waitDBInstanceAvailable
+	WindowsOverlap                          = windowsOverlap
 )
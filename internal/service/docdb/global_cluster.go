@@ -7,9 +7,11 @@ import (
 	"context"
 	"log"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/docdb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -20,12 +22,15 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKResource("aws_docdb_global_cluster")
+// @SDKResource("aws_docdb_global_cluster", name="Global Cluster")
+// @Tags(identifierAttribute="arn")
 func resourceGlobalCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGlobalClusterCreate,
@@ -94,6 +99,26 @@ func resourceGlobalCluster() *schema.Resource {
 					},
 				},
 			},
+			"global_cluster_member_regions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_cluster_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_writer": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"global_cluster_resource_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -116,7 +141,11 @@ func resourceGlobalCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
+
+		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
 
@@ -166,6 +195,11 @@ func resourceGlobalClusterCreate(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "waiting for DocumentDB Global Cluster (%s) create: %s", d.Id(), err)
 	}
 
+	// CreateGlobalCluster does not accept tags.
+	if err := createTags(ctx, conn, aws.ToString(output.GlobalCluster.GlobalClusterArn), getTagsIn(ctx)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting DocumentDB Global Cluster (%s) tags: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceGlobalClusterRead(ctx, d, meta)...)
 }
 
@@ -195,6 +229,9 @@ func resourceGlobalClusterRead(ctx context.Context, d *schema.ResourceData, meta
 	if err := d.Set("global_cluster_members", flattenGlobalClusterMembers(globalCluster.GlobalClusterMembers)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting global_cluster_members: %s", err)
 	}
+	if err := d.Set("global_cluster_member_regions", flattenGlobalClusterMemberRegions(globalCluster.GlobalClusterMembers)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting global_cluster_member_regions: %s", err)
+	}
 	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
 	d.Set(names.AttrStorageEncrypted, globalCluster.StorageEncrypted)
 
@@ -473,3 +510,55 @@ func flattenGlobalClusterMembers(apiObjects []awstypes.GlobalClusterMember) []in
 
 	return tfList
 }
+
+func globalClusterMemberRegion(apiObject awstypes.GlobalClusterMember) string {
+	v, err := arn.Parse(aws.ToString(apiObject.DBClusterArn))
+
+	if err != nil {
+		return ""
+	}
+
+	return v.Region
+}
+
+// flattenGlobalClusterMemberRegions returns the members ordered with the writer first,
+// followed by the readers sorted by Region.
+func flattenGlobalClusterMemberRegions(apiObjects []awstypes.GlobalClusterMember) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	apiObjects = slices.Clone(apiObjects)
+	slices.SortStableFunc(apiObjects, func(a, b awstypes.GlobalClusterMember) int {
+		if aws.ToBool(a.IsWriter) != aws.ToBool(b.IsWriter) {
+			if aws.ToBool(a.IsWriter) {
+				return -1
+			}
+			return 1
+		}
+
+		if v := strings.Compare(globalClusterMemberRegion(a), globalClusterMemberRegion(b)); v != 0 {
+			return v
+		}
+
+		return strings.Compare(aws.ToString(a.DBClusterArn), aws.ToString(b.DBClusterArn))
+	})
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		clusterARN := aws.ToString(apiObject.DBClusterArn)
+		tfMap := map[string]interface{}{
+			"db_cluster_arn": clusterARN,
+			"is_writer":      aws.ToBool(apiObject.IsWriter),
+		}
+
+		if v := globalClusterMemberRegion(apiObject); v != "" {
+			tfMap[names.AttrRegion] = v
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
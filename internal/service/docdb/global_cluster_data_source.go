@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docdb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_docdb_global_cluster", name="Global Cluster")
+func dataSourceGlobalCluster() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceGlobalClusterRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDatabaseName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDeletionProtection: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			names.AttrEngine: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrEngineVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"global_cluster_members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_cluster_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_writer": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"global_cluster_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStorageEncrypted: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGlobalClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DocDBClient(ctx)
+
+	globalClusterID := d.Get("global_cluster_identifier").(string)
+	globalCluster, err := findGlobalClusterByID(ctx, conn, globalClusterID)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("DocumentDB Global Cluster", err))
+	}
+
+	d.SetId(aws.ToString(globalCluster.GlobalClusterIdentifier))
+	d.Set(names.AttrARN, globalCluster.GlobalClusterArn)
+	d.Set(names.AttrDatabaseName, globalCluster.DatabaseName)
+	d.Set(names.AttrDeletionProtection, globalCluster.DeletionProtection)
+	d.Set(names.AttrEngine, globalCluster.Engine)
+	d.Set(names.AttrEngineVersion, globalCluster.EngineVersion)
+	d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
+	if err := d.Set("global_cluster_members", flattenGlobalClusterMembers(globalCluster.GlobalClusterMembers)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting global_cluster_members: %s", err)
+	}
+	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
+	d.Set(names.AttrStatus, globalCluster.Status)
+	d.Set(names.AttrStorageEncrypted, globalCluster.StorageEncrypted)
+
+	return diags
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docdb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdb"
+	tfdocdb "github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type docDBClientFactory interface {
+	NewClient(context.Context, map[string]any) (*docdb.Client, error)
+}
+
+func TestNewClientEndpointOverride(t *testing.T) {
+	t.Parallel()
+
+	const endpoint = "http://localhost:4566/"
+
+	ctx := context.Background()
+	sp, ok := tfdocdb.ServicePackage(ctx).(docDBClientFactory)
+	if !ok {
+		t.Fatal("service package does not implement NewClient")
+	}
+
+	client, err := sp.NewClient(ctx, map[string]any{
+		"aws_sdkv2_config": &aws.Config{
+			Credentials: aws.AnonymousCredentials{},
+			Region:      "us-west-2", //lintignore:AWSAT003
+		},
+		names.AttrEndpoint: endpoint,
+	})
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	operations := map[string]func(context.Context, ...func(*docdb.Options)) error{
+		"CreateDBClusterSnapshot": func(ctx context.Context, optFns ...func(*docdb.Options)) error {
+			_, err := client.CreateDBClusterSnapshot(ctx, &docdb.CreateDBClusterSnapshotInput{
+				DBClusterIdentifier:         aws.String("test"),
+				DBClusterSnapshotIdentifier: aws.String("test"),
+			}, optFns...)
+			return err
+		},
+		"DescribeDBClusters": func(ctx context.Context, optFns ...func(*docdb.Options)) error {
+			_, err := client.DescribeDBClusters(ctx, &docdb.DescribeDBClustersInput{}, optFns...)
+			return err
+		},
+		"DescribeDBInstances": func(ctx context.Context, optFns ...func(*docdb.Options)) error {
+			_, err := client.DescribeDBInstances(ctx, &docdb.DescribeDBInstancesInput{}, optFns...)
+			return err
+		},
+		"DescribeGlobalClusters": func(ctx context.Context, optFns ...func(*docdb.Options)) error {
+			_, err := client.DescribeGlobalClusters(ctx, &docdb.DescribeGlobalClustersInput{}, optFns...)
+			return err
+		},
+	}
+
+	for name, operation := range operations {
+		for _, useFIPS := range []bool{false, true} {
+			testName := name
+			if useFIPS {
+				testName += " with FIPS"
+			}
+
+			t.Run(testName, func(t *testing.T) {
+				t.Parallel()
+
+				var got string
+				err := operation(ctx, func(o *docdb.Options) {
+					if useFIPS {
+						o.EndpointOptions.UseFIPSEndpoint = aws.FIPSEndpointStateEnabled
+					}
+					o.APIOptions = append(o.APIOptions,
+						addRetrieveEndpointURLMiddleware(t, &got),
+						addCancelRequestMiddleware(),
+					)
+				})
+
+				if !errors.Is(err, errCancelOperation) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if got != endpoint {
+					t.Errorf("endpoint = %q, want %q", got, endpoint)
+				}
+			})
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -134,6 +135,24 @@ func TestAccDocDBSubnetGroup_namePrefix(t *testing.T) {
 	})
 }
 
+func TestAccDocDBSubnetGroup_nameAndNamePrefixConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubnetGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSubnetGroupConfig_nameAndNamePrefix(rName, "tf-acc-test-prefix-"),
+				ExpectError: regexache.MustCompile(`"name": conflicts with name_prefix`),
+			},
+		},
+	})
+}
+
 func TestAccDocDBSubnetGroup_updateDescription(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.DBSubnetGroup
@@ -323,6 +342,16 @@ resource "aws_docdb_subnet_group" "test" {
 `, namePrefix))
 }
 
+func testAccSubnetGroupConfig_nameAndNamePrefix(rName, namePrefix string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_docdb_subnet_group" "test" {
+  name        = %[1]q
+  name_prefix = %[2]q
+  subnet_ids  = aws_subnet.test[*].id
+}
+`, rName, namePrefix))
+}
+
 func testAccSubnetGroupConfig_description(rName, description string) string {
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
 resource "aws_docdb_subnet_group" "test" {
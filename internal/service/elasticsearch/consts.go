@@ -10,3 +10,14 @@ import (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	// UltraWarm requires at least this many data nodes and this minimum Elasticsearch version.
+	warmMinimumInstanceCount        = 2
+	warmMinimumElasticsearchVersion = "6.8"
+)
+
+const (
+	reservedInstanceStateActive         = "active"
+	reservedInstanceStatePaymentPending = "payment-pending"
+)
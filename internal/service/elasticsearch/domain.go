@@ -5,6 +5,7 @@ package elasticsearch
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"slices"
@@ -16,6 +17,7 @@ import (
 	elasticsearch "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -23,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -86,6 +89,12 @@ func resourceDomain() *schema.Resource {
 
 				return !inPlaceEncryptionEnableVersion(d.Get("elasticsearch_version").(string))
 			}),
+			customdiff.ForceNewIf("encrypt_at_rest.0.kms_key_id", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				// The KMS key of an encrypted domain is immutable. A key may only be
+				// supplied without replacement when encryption is being enabled in place.
+				o, _ := d.GetChange("encrypt_at_rest.0.kms_key_id")
+				return o.(string) != ""
+			}),
 			customdiff.ForceNewIf("node_to_node_encryption.0.enabled", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
 				o, n := d.GetChange("node_to_node_encryption.0.enabled")
 				if o.(bool) && !n.(bool) {
@@ -94,6 +103,32 @@ func resourceDomain() *schema.Resource {
 
 				return !inPlaceEncryptionEnableVersion(d.Get("elasticsearch_version").(string))
 			}),
+			customdiff.ForceNewIf("vpc_options", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				o, n := d.GetChange("vpc_options")
+				return vpcOptionsRequiresReplacement(o.([]interface{}), n.([]interface{}))
+			}),
+			// change_progress is refreshed by Update whenever it changes anything other than tags.
+			customdiff.ComputedIf("change_progress", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				if d.Id() == "" {
+					return false
+				}
+
+				for _, key := range d.GetChangedKeysPrefix("") {
+					if !strings.HasPrefix(key, names.AttrTags) {
+						return true
+					}
+				}
+
+				return false
+			}),
+			customizeDiffDedicatedMaster,
+			customizeDiffDedicatedMasterDisabled,
+			customizeDiffInternalUserDatabase,
+			customizeDiffVPCOptionsSecurityGroupIDs,
+			customizeDiffEBSOptions,
+			customizeDiffCustomEndpointCertificateARN,
+			customizeDiffAuditLogs,
+			customizeDiffWarm,
 			verify.SetTagsDiff,
 		),
 
@@ -123,6 +158,11 @@ func resourceDomain() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"anonymous_auth_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
 						names.AttrEnabled: {
 							Type:     schema.TypeBool,
 							Required: true,
@@ -220,6 +260,50 @@ func resourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"change_progress": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"change_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stages": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrDescription: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_updated": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrStatus: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_number_of_stages": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"cluster_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -436,7 +520,6 @@ func resourceDomain() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							Computed:         true,
-							ForceNew:         true,
 							DiffSuppressFunc: suppressEquivalentKMSKeyIDs,
 						},
 					},
@@ -446,10 +529,18 @@ func resourceDomain() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"endpoint_v2": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"kibana_endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"kibana_endpoint_v2": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"log_publishing_options": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -472,6 +563,7 @@ func resourceDomain() *schema.Resource {
 						},
 					},
 				},
+				Set: logPublishingOptionsHash,
 			},
 			"node_to_node_encryption": {
 				Type:     schema.TypeList,
@@ -506,7 +598,6 @@ func resourceDomain() *schema.Resource {
 			"vpc_options": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -698,6 +789,10 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		if _, err := waitDomainConfigUpdated(ctx, conn, name, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Elasticsearch Domain (%s) Config update: %s", d.Id(), err)
 		}
+
+		if err := setDomainChangeProgress(ctx, conn, d, name); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return append(diags, resourceDomainRead(ctx, d, meta)...)
@@ -796,8 +891,10 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 		endpoints := flex.FlattenStringValueMap(ds.Endpoints)
 		d.Set(names.AttrEndpoint, endpoints["vpc"])
+		d.Set("endpoint_v2", endpoints["vpcv2"])
 
 		d.Set("kibana_endpoint", getKibanaEndpoint(d))
+		d.Set("kibana_endpoint_v2", getKibanaEndpointV2(d))
 		if ds.Endpoint != nil {
 			return sdkdiag.AppendErrorf(diags, "%q: Elasticsearch domain in VPC expected to have null Endpoint value", d.Id())
 		}
@@ -828,7 +925,11 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			o, n := d.GetChange("access_policies")
 
 			if equivalent, err := awspolicy.PoliciesAreEquivalent(o.(string), n.(string)); err != nil || !equivalent {
-				input.AccessPolicies = aws.String(d.Get("access_policies").(string))
+				policy, err := structure.NormalizeJsonString(n.(string))
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", policy, err)
+				}
+				input.AccessPolicies = aws.String(policy)
 			}
 		}
 
@@ -898,8 +999,9 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 
 		if d.HasChange("vpc_options") {
-			v := d.Get("vpc_options").([]interface{})
-			input.VPCOptions = expandVPCOptions(v[0].(map[string]interface{}))
+			if v := d.Get("vpc_options").([]interface{}); len(v) > 0 && v[0] != nil {
+				input.VPCOptions = expandVPCOptions(v[0].(map[string]interface{}))
+			}
 		}
 
 		if d.HasChange("cognito_options") {
@@ -921,26 +1023,125 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 
 		if d.HasChange("elasticsearch_version") {
-			input := &elasticsearch.UpgradeElasticsearchDomainInput{
-				DomainName:    aws.String(name),
-				TargetVersion: aws.String(d.Get("elasticsearch_version").(string)),
-			}
+			diags = append(diags, upgradeDomain(ctx, conn, name, d.Get("elasticsearch_version").(string), d.Timeout(schema.TimeoutUpdate))...)
 
-			_, err := conn.UpgradeElasticsearchDomain(ctx, input)
-
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "upgrading Elasticsearch Domain (%s): %s", d.Id(), err)
+			if diags.HasError() {
+				return diags
 			}
+		}
 
-			if _, err := waitUpgradeSucceeded(ctx, conn, name, d.Timeout(schema.TimeoutUpdate)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "waiting for Elasticsearch Domain (%s) upgrade: %s", d.Id(), err)
-			}
+		if err := setDomainChangeProgress(ctx, conn, d, name); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
 
 	return append(diags, resourceDomainRead(ctx, d, meta)...)
 }
 
+// setDomainChangeProgress sets change_progress from the domain's most recent configuration change.
+// The change progress record is only meaningful after a configuration change, so it isn't refreshed on read.
+func setDomainChangeProgress(ctx context.Context, conn *elasticsearch.Client, d *schema.ResourceData, name string) error {
+	progress, err := findDomainChangeProgressByName(ctx, conn, name)
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("change_progress", nil)
+	case err != nil:
+		return fmt.Errorf("reading Elasticsearch Domain (%s) change progress: %w", d.Id(), err)
+	default:
+		if err := d.Set("change_progress", flattenChangeProgressStatusDetails(progress)); err != nil {
+			return fmt.Errorf("setting change_progress: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// upgradeDomain upgrades a domain to the specified Elasticsearch version using a blue/green deployment.
+// A check-only upgrade is run first so that an ineligible domain is reported before any change is made.
+// An upgrade that succeeds with issues is reported as a warning.
+func upgradeDomain(ctx context.Context, conn *elasticsearch.Client, name, targetVersion string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	input := &elasticsearch.UpgradeElasticsearchDomainInput{
+		DomainName:       aws.String(name),
+		PerformCheckOnly: aws.Bool(true),
+		TargetVersion:    aws.String(targetVersion),
+	}
+
+	if _, err := conn.UpgradeElasticsearchDomain(ctx, input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "checking Elasticsearch Domain (%s) upgrade eligibility: %s", name, err)
+	}
+
+	if _, err := waitUpgradeCheckSucceeded(ctx, conn, name, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Elasticsearch Domain (%s) upgrade eligibility check: %s", name, upgradeError(ctx, conn, name, err))
+	}
+
+	input.PerformCheckOnly = aws.Bool(false)
+
+	if _, err := conn.UpgradeElasticsearchDomain(ctx, input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "upgrading Elasticsearch Domain (%s): %s", name, err)
+	}
+
+	output, err := waitUpgradeSucceeded(ctx, conn, name, timeout)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Elasticsearch Domain (%s) upgrade: %s", name, upgradeError(ctx, conn, name, err))
+	}
+
+	if output.StepStatus == awstypes.UpgradeStatusSucceededWithIssues {
+		diags = sdkdiag.AppendWarningf(diags, "Elasticsearch Domain (%s) upgrade to %s succeeded with issues: %s", name, targetVersion, strings.Join(findLatestUpgradeIssues(ctx, conn, name), "; "))
+	}
+
+	if _, err := waitDomainConfigUpdated(ctx, conn, name, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Elasticsearch Domain (%s) Config update: %s", name, err)
+	}
+
+	return diags
+}
+
+// upgradeError adds the issues reported by the most recent upgrade attempt to err.
+func upgradeError(ctx context.Context, conn *elasticsearch.Client, name string, err error) error {
+	if issues := findLatestUpgradeIssues(ctx, conn, name); len(issues) > 0 {
+		return fmt.Errorf("%w: %s", err, strings.Join(issues, "; "))
+	}
+
+	return err
+}
+
+// findLatestUpgradeIssues returns the issues reported by each step of the most recent upgrade attempt.
+// Errors are ignored as the issues are supplementary diagnostic information.
+func findLatestUpgradeIssues(ctx context.Context, conn *elasticsearch.Client, name string) []string {
+	output, err := conn.GetUpgradeHistory(ctx, &elasticsearch.GetUpgradeHistoryInput{
+		DomainName: aws.String(name),
+	})
+
+	if err != nil {
+		log.Printf("[WARN] reading Elasticsearch Domain (%s) upgrade history: %s", name, err)
+		return nil
+	}
+
+	var latest *awstypes.UpgradeHistory
+	for i, v := range output.UpgradeHistories {
+		if latest == nil || aws.ToTime(v.StartTimestamp).After(aws.ToTime(latest.StartTimestamp)) {
+			latest = &output.UpgradeHistories[i]
+		}
+	}
+
+	if latest == nil {
+		return nil
+	}
+
+	var issues []string
+	for _, step := range latest.StepsList {
+		for _, issue := range step.Issues {
+			issues = append(issues, fmt.Sprintf("%s: %s", step.UpgradeStep, issue))
+		}
+	}
+
+	return issues
+}
+
 func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
@@ -1023,6 +1224,37 @@ func findDomain(ctx context.Context, conn *elasticsearch.Client, input *elastics
 	return output.DomainStatus, nil
 }
 
+// findCompatibleElasticsearchVersionsByDomainName returns the Elasticsearch versions the domain can be upgraded to.
+func findCompatibleElasticsearchVersionsByDomainName(ctx context.Context, conn *elasticsearch.Client, name string) ([]string, error) {
+	input := &elasticsearch.GetCompatibleElasticsearchVersionsInput{
+		DomainName: aws.String(name),
+	}
+
+	output, err := conn.GetCompatibleElasticsearchVersions(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	var versions []string
+	for _, v := range output.CompatibleElasticsearchVersions {
+		versions = append(versions, v.TargetVersions...)
+	}
+
+	return versions, nil
+}
+
 func findDomainConfigByName(ctx context.Context, conn *elasticsearch.Client, name string) (*awstypes.ElasticsearchDomainConfig, error) {
 	input := &elasticsearch.DescribeElasticsearchDomainConfigInput{
 		DomainName: aws.String(name),
@@ -1052,6 +1284,35 @@ func findDomainConfig(ctx context.Context, conn *elasticsearch.Client, input *el
 	return output.DomainConfig, nil
 }
 
+func findDomainChangeProgressByName(ctx context.Context, conn *elasticsearch.Client, name string) (*awstypes.ChangeProgressStatusDetails, error) {
+	input := &elasticsearch.DescribeDomainChangeProgressInput{
+		DomainName: aws.String(name),
+	}
+
+	return findDomainChangeProgress(ctx, conn, input)
+}
+
+func findDomainChangeProgress(ctx context.Context, conn *elasticsearch.Client, input *elasticsearch.DescribeDomainChangeProgressInput) (*awstypes.ChangeProgressStatusDetails, error) {
+	output, err := conn.DescribeDomainChangeProgress(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ChangeProgressStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ChangeProgressStatus, nil
+}
+
 func findDomainUpgradeStatusByName(ctx context.Context, conn *elasticsearch.Client, name string) (*elasticsearch.GetUpgradeStatusOutput, error) {
 	input := &elasticsearch.GetUpgradeStatusInput{
 		DomainName: aws.String(name),
@@ -1097,11 +1358,36 @@ func statusDomainProcessing(ctx context.Context, conn *elasticsearch.Client, nam
 			return nil, "", err
 		}
 
-		return output, string(output.DomainProcessingStatus), nil
+		return output, domainProcessingStatus(output), nil
+	}
+}
+
+// domainProcessingStatus returns the effective processing status of a domain.
+// DomainProcessingStatus can report Active while a configuration change or engine upgrade
+// is still being applied, so the Processing and UpgradeProcessing flags are also consulted.
+func domainProcessingStatus(apiObject *awstypes.ElasticsearchDomainStatus) string {
+	status := apiObject.DomainProcessingStatus
+
+	switch {
+	case aws.ToBool(apiObject.Deleted):
+		status = awstypes.DomainProcessingStatusTypeDeleting
+	case status == "" || status == awstypes.DomainProcessingStatusTypeActive:
+		switch {
+		case aws.ToBool(apiObject.UpgradeProcessing):
+			status = awstypes.DomainProcessingStatusTypeUpgrading
+		case aws.ToBool(apiObject.Processing):
+			status = awstypes.DomainProcessingStatusTypeModifying
+		default:
+			status = awstypes.DomainProcessingStatusTypeActive
+		}
 	}
+
+	return string(status)
 }
 
-func statusDomainUpgrade(ctx context.Context, conn *elasticsearch.Client, name string) retry.StateRefreshFunc {
+// statusDomainUpgrade returns the status of the domain's current upgrade, which is only
+// complete once the specified step has finished.
+func statusDomainUpgrade(ctx context.Context, conn *elasticsearch.Client, name string, finalStep awstypes.UpgradeStep) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findDomainUpgradeStatusByName(ctx, conn, name)
 
@@ -1115,9 +1401,9 @@ func statusDomainUpgrade(ctx context.Context, conn *elasticsearch.Client, name s
 
 		// Elasticsearch upgrades consist of multiple steps:
 		// https://docs.aws.amazon.com/elasticsearch-service/latest/developerguide/es-version-migration.html
-		// Prevent false positive completion where the UpgradeStep is not the final UPGRADE step.
+		// Prevent false positive completion where the UpgradeStep is not the final step.
 		status := output.StepStatus
-		if status == awstypes.UpgradeStatusSucceeded && output.UpgradeStep != awstypes.UpgradeStepUpgrade {
+		if (status == awstypes.UpgradeStatusSucceeded || status == awstypes.UpgradeStatusSucceededWithIssues) && output.UpgradeStep != finalStep {
 			status = awstypes.UpgradeStatusInProgress
 		}
 
@@ -1125,11 +1411,30 @@ func statusDomainUpgrade(ctx context.Context, conn *elasticsearch.Client, name s
 	}
 }
 
+func waitUpgradeCheckSucceeded(ctx context.Context, conn *elasticsearch.Client, name string, timeout time.Duration) (*elasticsearch.GetUpgradeStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.UpgradeStatusInProgress),
+		Target:     enum.Slice(awstypes.UpgradeStatusSucceeded, awstypes.UpgradeStatusSucceededWithIssues),
+		Refresh:    statusDomainUpgrade(ctx, conn, name, awstypes.UpgradeStepPreUpgradeCheck),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*elasticsearch.GetUpgradeStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitUpgradeSucceeded(ctx context.Context, conn *elasticsearch.Client, name string, timeout time.Duration) (*elasticsearch.GetUpgradeStatusOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(awstypes.UpgradeStatusInProgress),
 		Target:     enum.Slice(awstypes.UpgradeStatusSucceeded, awstypes.UpgradeStatusSucceededWithIssues),
-		Refresh:    statusDomainUpgrade(ctx, conn, name),
+		Refresh:    statusDomainUpgrade(ctx, conn, name, awstypes.UpgradeStepUpgrade),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
@@ -1146,7 +1451,7 @@ func waitUpgradeSucceeded(ctx context.Context, conn *elasticsearch.Client, name
 
 func waitDomainCreated(ctx context.Context, conn *elasticsearch.Client, domainName string, timeout time.Duration) (*awstypes.ElasticsearchDomainStatus, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.DomainProcessingStatusTypeCreating),
+		Pending: enum.Slice(awstypes.DomainProcessingStatusTypeCreating, awstypes.DomainProcessingStatusTypeModifying, awstypes.DomainProcessingStatusTypeUpgrading),
 		Target:  enum.Slice(awstypes.DomainProcessingStatusTypeActive),
 		Refresh: statusDomainProcessing(ctx, conn, domainName),
 		Timeout: timeout,
@@ -1167,10 +1472,13 @@ func waitDomainCreated(ctx context.Context, conn *elasticsearch.Client, domainNa
 
 func waitDomainConfigUpdated(ctx context.Context, conn *elasticsearch.Client, domainName string, timeout time.Duration) (*awstypes.ElasticsearchDomainStatus, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.DomainProcessingStatusTypeModifying),
-		Target:  enum.Slice(awstypes.DomainProcessingStatusTypeActive),
-		Refresh: statusDomainProcessing(ctx, conn, domainName),
-		Timeout: timeout,
+		Pending:    enum.Slice(awstypes.DomainProcessingStatusTypeModifying, awstypes.DomainProcessingStatusTypeUpgrading, awstypes.DomainProcessingStatusTypeUpdating),
+		Target:     enum.Slice(awstypes.DomainProcessingStatusTypeActive),
+		Refresh:    statusDomainProcessing(ctx, conn, domainName),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		// Processing may not be reported immediately after a configuration change is accepted.
+		ContinuousTargetOccurence: 2,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -1188,7 +1496,7 @@ func waitDomainConfigUpdated(ctx context.Context, conn *elasticsearch.Client, do
 
 func waitDomainDeleted(ctx context.Context, conn *elasticsearch.Client, domainName string, timeout time.Duration) (*awstypes.ElasticsearchDomainStatus, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.DomainProcessingStatusTypeDeleting),
+		Pending: enum.Slice(awstypes.DomainProcessingStatusTypeDeleting, awstypes.DomainProcessingStatusTypeActive, awstypes.DomainProcessingStatusTypeModifying),
 		Target:  []string{},
 		Refresh: statusDomainProcessing(ctx, conn, domainName),
 		Timeout: timeout,
@@ -1228,6 +1536,14 @@ func getKibanaEndpoint(d *schema.ResourceData) string {
 	return d.Get(names.AttrEndpoint).(string) + "/_plugin/kibana/"
 }
 
+func getKibanaEndpointV2(d *schema.ResourceData) string {
+	if v := d.Get("endpoint_v2").(string); v != "" {
+		return v + "/_plugin/kibana/"
+	}
+
+	return ""
+}
+
 func isDedicatedMasterDisabled(k, old, new string, d *schema.ResourceData) bool {
 	if v, ok := d.GetOk("cluster_config"); ok {
 		tfMap := v.([]interface{})[0].(map[string]interface{})
@@ -1236,6 +1552,223 @@ func isDedicatedMasterDisabled(k, old, new string, d *schema.ResourceData) bool
 	return false
 }
 
+// customizeDiffDedicatedMaster validates the dedicated master node configuration when dedicated master nodes are enabled.
+func customizeDiffDedicatedMaster(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Only validate new domains and changes to the dedicated master configuration,
+	// so that existing domains created before this validation can still be planned.
+	if d.Id() != "" && !d.HasChanges("cluster_config.0.dedicated_master_enabled", "cluster_config.0.dedicated_master_count", "cluster_config.0.dedicated_master_type") {
+		return nil
+	}
+
+	if !d.NewValueKnown("cluster_config.0.dedicated_master_enabled") || !d.Get("cluster_config.0.dedicated_master_enabled").(bool) {
+		return nil
+	}
+
+	if d.NewValueKnown("cluster_config.0.dedicated_master_type") && d.Get("cluster_config.0.dedicated_master_type").(string) == "" {
+		return errors.New("cluster_config.0.dedicated_master_type: must be set when dedicated_master_enabled is true")
+	}
+
+	if d.NewValueKnown("cluster_config.0.dedicated_master_count") {
+		// An odd number of at least three master nodes is needed to keep a quorum when one of them fails.
+		if count := d.Get("cluster_config.0.dedicated_master_count").(int); count != 3 && count != 5 {
+			return fmt.Errorf("cluster_config.0.dedicated_master_count: must be 3 or 5 when dedicated_master_enabled is true (dedicated_master_count = %d)", count)
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffDedicatedMasterDisabled returns an error if dedicated master nodes are being
+// disabled on an existing domain with UltraWarm nodes, which cannot run without them.
+// Enabling dedicated master nodes, and otherwise disabling them, is done in place.
+func customizeDiffDedicatedMasterDisabled(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("cluster_config.0.dedicated_master_enabled") {
+		return nil
+	}
+
+	if o, n := d.GetChange("cluster_config.0.dedicated_master_enabled"); !o.(bool) || n.(bool) {
+		return nil
+	}
+
+	if d.Get("cluster_config.0.warm_enabled").(bool) {
+		return errors.New("cluster_config.0.dedicated_master_enabled: dedicated master nodes cannot be disabled in place on a domain with UltraWarm nodes; disable warm_enabled first or replace the domain")
+	}
+
+	return nil
+}
+
+func customizeDiffInternalUserDatabase(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("advanced_security_options.0.internal_user_database_enabled") {
+		return nil
+	}
+
+	if !d.Get("advanced_security_options.0.enabled").(bool) || !d.Get("advanced_security_options.0.internal_user_database_enabled").(bool) {
+		return nil
+	}
+
+	for _, k := range []string{"master_user_name", "master_user_password"} {
+		key := "advanced_security_options.0.master_user_options.0." + k
+		if d.NewValueKnown(key) && d.Get(key).(string) == "" {
+			return fmt.Errorf("advanced_security_options.0.master_user_options.0.%s must be set when internal_user_database_enabled is true", k)
+		}
+	}
+
+	return nil
+}
+
+// vpcOptionsRequiresReplacement reports whether a vpc_options change moves the domain into or out of a VPC.
+// The security groups and subnets of a domain that stays in a VPC are updated in place.
+func vpcOptionsRequiresReplacement(o, n []interface{}) bool {
+	inVPC := func(v []interface{}) bool {
+		return len(v) > 0 && v[0] != nil
+	}
+
+	return inVPC(o) != inVPC(n)
+}
+
+func customizeDiffVPCOptionsSecurityGroupIDs(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return validateVPCOptionsSecurityGroupIDs(d.GetRawConfig().GetAttr("vpc_options"))
+}
+
+// validateVPCOptionsSecurityGroupIDs rejects an explicitly empty vpc_options.security_group_ids.
+// Omitting the argument is allowed, in which case the VPC's default security group is used.
+func validateVPCOptionsSecurityGroupIDs(vpcOptions cty.Value) error {
+	if !vpcOptions.IsKnown() || vpcOptions.IsNull() || vpcOptions.LengthInt() == 0 {
+		return nil
+	}
+
+	v := vpcOptions.Index(cty.NumberIntVal(0))
+	if !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+
+	if v := v.GetAttr(names.AttrSecurityGroupIDs); v.IsKnown() && !v.IsNull() && v.LengthInt() == 0 {
+		return errors.New("vpc_options.0.security_group_ids: at least one security group ID must be specified, or omit the argument to use the VPC's default security group")
+	}
+
+	return nil
+}
+
+// customizeDiffEBSOptions rejects a configured ebs_options.iops or ebs_options.throughput that the configured volume_type does not support.
+// The raw configuration is used as both are Computed and retain their prior values when removed,
+// e.g. when migrating a volume from gp3 back to gp2.
+func customizeDiffEBSOptions(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	ebsOptions := d.GetRawConfig().GetAttr("ebs_options")
+	if !ebsOptions.IsKnown() || ebsOptions.IsNull() || ebsOptions.LengthInt() == 0 {
+		return nil
+	}
+
+	v := ebsOptions.Index(cty.NumberIntVal(0))
+	if !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+
+	iops, throughput := v.GetAttr(names.AttrIOPS), v.GetAttr(names.AttrThroughput)
+	if iops.IsNull() && throughput.IsNull() {
+		return nil
+	}
+
+	// Only a volume_type set explicitly in the configuration is checked, as the domain's
+	// current volume type is used when it is omitted.
+	volumeType := v.GetAttr(names.AttrVolumeType)
+	if !volumeType.IsKnown() || volumeType.IsNull() {
+		return nil
+	}
+
+	vt := volumeType.AsString()
+
+	if !iops.IsNull() && !ebsVolumeTypePermitsIopsInput(vt) {
+		return fmt.Errorf("ebs_options.0.iops: can only be set when volume_type is %q or %q", awstypes.VolumeTypeGp3, awstypes.VolumeTypeIo1)
+	}
+
+	if !throughput.IsNull() && !ebsVolumeTypePermitsThroughputInput(vt) {
+		return fmt.Errorf("ebs_options.0.throughput: can only be set when volume_type is %q", awstypes.VolumeTypeGp3)
+	}
+
+	return nil
+}
+
+// customizeDiffCustomEndpointCertificateARN requires domain_endpoint_options.custom_endpoint_certificate_arn
+// whenever a custom endpoint is enabled, as the domain cannot serve the custom endpoint without a certificate.
+func customizeDiffCustomEndpointCertificateARN(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("domain_endpoint_options.0.custom_endpoint_enabled") || !d.Get("domain_endpoint_options.0.custom_endpoint_enabled").(bool) {
+		return nil
+	}
+
+	if !d.NewValueKnown("domain_endpoint_options.0.custom_endpoint") || d.Get("domain_endpoint_options.0.custom_endpoint").(string) == "" {
+		return nil
+	}
+
+	if d.NewValueKnown("domain_endpoint_options.0.custom_endpoint_certificate_arn") && d.Get("domain_endpoint_options.0.custom_endpoint_certificate_arn").(string) == "" {
+		return errors.New("domain_endpoint_options.0.custom_endpoint_certificate_arn must be set when custom_endpoint_enabled is true and custom_endpoint is specified")
+	}
+
+	return nil
+}
+
+// customizeDiffAuditLogs requires fine-grained access control when AUDIT_LOGS publishing is enabled.
+func customizeDiffAuditLogs(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("log_publishing_options") || !d.NewValueKnown("advanced_security_options.0.enabled") {
+		return nil
+	}
+
+	if d.Get("advanced_security_options.0.enabled").(bool) {
+		return nil
+	}
+
+	for _, tfMapRaw := range d.Get("log_publishing_options").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if tfMap["log_type"].(string) == string(awstypes.LogTypeAuditLogs) && tfMap[names.AttrEnabled].(bool) {
+			return fmt.Errorf("log_publishing_options: %s can only be enabled when advanced_security_options.0.enabled is true", awstypes.LogTypeAuditLogs)
+		}
+	}
+
+	return nil
+}
+
+// logPublishingOptionsHash keys log_publishing_options elements on log_type, which is unique per domain.
+func logPublishingOptionsHash(v interface{}) int {
+	return create.StringHashcode(v.(map[string]interface{})["log_type"].(string))
+}
+
+// customizeDiffWarm validates the cluster configuration that UltraWarm nodes depend on,
+// returning errors against the offending attribute rather than the API's generic validation error.
+func customizeDiffWarm(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("cluster_config.0.warm_enabled") || !d.Get("cluster_config.0.warm_enabled").(bool) {
+		return nil
+	}
+
+	if d.NewValueKnown("cluster_config.0.dedicated_master_enabled") && !d.Get("cluster_config.0.dedicated_master_enabled").(bool) {
+		return errors.New("cluster_config.0.dedicated_master_enabled: must be true when warm_enabled is true")
+	}
+
+	if d.NewValueKnown("cluster_config.0.instance_count") {
+		if instanceCount := d.Get("cluster_config.0.instance_count").(int); instanceCount < warmMinimumInstanceCount {
+			return fmt.Errorf("cluster_config.0.instance_count: must be at least %d when warm_enabled is true (instance_count = %d)", warmMinimumInstanceCount, instanceCount)
+		}
+	}
+
+	if d.NewValueKnown("cluster_config.0.warm_count") && d.Get("cluster_config.0.warm_count").(int) == 0 {
+		return errors.New("cluster_config.0.warm_count: must be set when warm_enabled is true")
+	}
+
+	if d.NewValueKnown("cluster_config.0.warm_type") && d.Get("cluster_config.0.warm_type").(string) == "" {
+		return errors.New("cluster_config.0.warm_type: must be set when warm_enabled is true")
+	}
+
+	if d.NewValueKnown("elasticsearch_version") {
+		if version := d.Get("elasticsearch_version").(string); !semver.GreaterThanOrEqual(version, warmMinimumElasticsearchVersion) {
+			return fmt.Errorf("elasticsearch_version: must be %s or later when cluster_config.0.warm_enabled is true (elasticsearch_version = %s)", warmMinimumElasticsearchVersion, version)
+		}
+	}
+
+	return nil
+}
+
 func isCustomEndpointDisabled(k, old, new string, d *schema.ResourceData) bool {
 	if v, ok := d.GetOk("domain_endpoint_options"); ok {
 		tfMap := v.([]interface{})[0].(map[string]interface{})
@@ -1409,6 +1942,36 @@ func flattenZoneAwarenessConfig(apiObject *awstypes.ZoneAwarenessConfig) []inter
 	return []interface{}{tfMap}
 }
 
+func flattenChangeProgressStatusDetails(apiObject *awstypes.ChangeProgressStatusDetails) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	var stages []interface{}
+	for _, v := range apiObject.ChangeProgressStages {
+		stage := map[string]interface{}{
+			names.AttrDescription: aws.ToString(v.Description),
+			names.AttrName:        aws.ToString(v.Name),
+			names.AttrStatus:      aws.ToString(v.Status),
+		}
+
+		if v.LastUpdated != nil {
+			stage["last_updated"] = aws.ToTime(v.LastUpdated).Format(time.RFC3339)
+		}
+
+		stages = append(stages, stage)
+	}
+
+	tfMap := map[string]interface{}{
+		"change_id":              aws.ToString(apiObject.ChangeId),
+		"stages":                 stages,
+		names.AttrStatus:         string(apiObject.Status),
+		"total_number_of_stages": apiObject.TotalNumberOfStages,
+	}
+
+	return []interface{}{tfMap}
+}
+
 // advancedOptionsIgnoreDefault checks for defaults in the n map and, if
 // they don't exist in the o map, it deletes them. AWS returns default advanced
 // options that cause perpetual diffs.
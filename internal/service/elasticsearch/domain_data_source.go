@@ -5,14 +5,20 @@ package elasticsearch
 
 import (
 	"context"
+	"fmt"
+	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	elasticsearch "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -36,6 +42,10 @@ func dataSourceDomain() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"anonymous_auth_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
 						names.AttrEnabled: {
 							Type:     schema.TypeBool,
 							Computed: true,
@@ -44,6 +54,46 @@ func dataSourceDomain() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"saml_options": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrEnabled: {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"idp": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"entity_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"metadata_content": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"roles_key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"session_timeout_minutes": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"subject_key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -191,6 +241,11 @@ func dataSourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"compatible_elasticsearch_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"created": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -204,8 +259,10 @@ func dataSourceDomain() *schema.Resource {
 				Computed: true,
 			},
 			names.AttrDomainName: {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{names.AttrDomainName, names.AttrTags},
 			},
 			"ebs_options": {
 				Type:     schema.TypeList,
@@ -312,6 +369,10 @@ func dataSourceDomain() *schema.Resource {
 				},
 			},
 			names.AttrTags: tftags.TagsSchemaComputed(),
+			"upgrade_processing": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"vpc_options": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -349,6 +410,17 @@ func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta inte
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
 
 	domainName := d.Get(names.AttrDomainName).(string)
+	if domainName == "" {
+		tagsToMatch := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+		v, err := findDomainNameByTags(ctx, conn, meta.(*conns.AWSClient), tagsToMatch)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Elasticsearch Domain", err))
+		}
+
+		domainName = v
+	}
+
 	ds, err := findDomainByName(ctx, conn, domainName)
 
 	if err != nil {
@@ -373,7 +445,11 @@ func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("advanced_options", ds.AdvancedOptions); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting advanced_options: %s", err)
 	}
-	if err := d.Set("advanced_security_options", flattenAdvancedSecurityOptions(ds.AdvancedSecurityOptions)); err != nil {
+	advancedSecurityOptions := flattenAdvancedSecurityOptions(ds.AdvancedSecurityOptions)
+	if len(advancedSecurityOptions) > 0 && ds.AdvancedSecurityOptions.SAMLOptions != nil {
+		advancedSecurityOptions[0]["saml_options"] = []interface{}{flattenSAMLOptions(ds.AdvancedSecurityOptions.SAMLOptions)}
+	}
+	if err := d.Set("advanced_security_options", advancedSecurityOptions); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting advanced_security_options: %s", err)
 	}
 	d.Set(names.AttrARN, ds.ARN)
@@ -388,9 +464,18 @@ func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("cognito_options", flattenCognitoOptions(ds.CognitoOptions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting cognito_options: %s", err)
 	}
+	// The compatible versions are left empty, rather than failing the read, when they can't be
+	// read, e.g. when the caller isn't allowed es:GetCompatibleElasticsearchVersions.
+	if compatibleVersions, err := findCompatibleElasticsearchVersionsByDomainName(ctx, conn, domainName); err != nil {
+		log.Printf("[WARN] reading Elasticsearch Domain (%s) compatible versions: %s", domainName, err)
+		d.Set("compatible_elasticsearch_versions", nil)
+	} else {
+		d.Set("compatible_elasticsearch_versions", compatibleVersions)
+	}
 	d.Set("created", ds.Created)
 	d.Set("deleted", ds.Deleted)
 	d.Set("domain_id", ds.DomainId)
+	d.Set(names.AttrDomainName, ds.DomainName)
 	if err := d.Set("ebs_options", flattenEBSOptions(ds.EBSOptions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting ebs_options: %s", err)
 	}
@@ -410,6 +495,7 @@ func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("snapshot_options", flattenSnapshotOptions(ds.SnapshotOptions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting snapshot_options: %s", err)
 	}
+	d.Set("upgrade_processing", ds.UpgradeProcessing)
 	if ds.VPCOptions != nil {
 		if err := d.Set("vpc_options", []interface{}{flattenVPCDerivedInfo(ds.VPCOptions)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting vpc_options: %s", err)
@@ -434,3 +520,42 @@ func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	return diags
 }
+
+func findDomainNameByTags(ctx context.Context, conn *elasticsearch.Client, client *conns.AWSClient, tagsToMatch tftags.KeyValueTags) (string, error) {
+	input := &elasticsearch.ListDomainNamesInput{}
+	output, err := conn.ListDomainNames(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	ignoreTagsConfig := client.IgnoreTagsConfig(ctx)
+	var domainNames []string
+
+	for _, v := range output.DomainNames {
+		name := aws.ToString(v.DomainName)
+		tags, err := listTags(ctx, conn, client.RegionalARN(ctx, "es", "domain/"+name))
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return "", fmt.Errorf("listing tags for Elasticsearch Domain (%s): %w", name, err)
+		}
+
+		if !tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).ContainsAll(tagsToMatch) {
+			continue
+		}
+
+		domainNames = append(domainNames, name)
+	}
+
+	name, err := tfresource.AssertSingleValueResult(domainNames)
+
+	if err != nil {
+		return "", err
+	}
+
+	return *name, nil
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -31,7 +32,11 @@ func TestAccElasticsearchDomainDataSource_basic(t *testing.T) {
 			{
 				Config: testAccDomainDataSourceConfig_basic(rName, autoTuneStartAtTime),
 				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "created", acctest.CtTrue),
+					resource.TestCheckResourceAttr(datasourceName, "deleted", acctest.CtFalse),
 					resource.TestCheckResourceAttr(datasourceName, "processing", acctest.CtFalse),
+					resource.TestCheckResourceAttr(datasourceName, "upgrade_processing", acctest.CtFalse),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "compatible_elasticsearch_versions.*", "6.8"),
 					resource.TestCheckResourceAttrPair(datasourceName, "elasticsearch_version", resourceName, "elasticsearch_version"),
 					resource.TestCheckResourceAttrPair(datasourceName, "auto_tune_options.#", resourceName, "auto_tune_options.#"),
 					resource.TestCheckResourceAttrPair(datasourceName, "auto_tune_options.0.desired_state", resourceName, "auto_tune_options.0.desired_state"),
@@ -56,6 +61,40 @@ func TestAccElasticsearchDomainDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccElasticsearchDomainDataSource_tags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	rName := testAccRandomDomainName()
+	datasourceName := "data.aws_elasticsearch_domain.test"
+	resourceName := "aws_elasticsearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIAMServiceLinkedRole(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainDataSourceConfig_tags(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrDomainName, resourceName, names.AttrDomainName),
+					resource.TestCheckResourceAttrPair(datasourceName, "elasticsearch_version", resourceName, "elasticsearch_version"),
+					resource.TestCheckResourceAttrPair(datasourceName, "ebs_options.0.volume_size", resourceName, "ebs_options.0.volume_size"),
+					resource.TestCheckResourceAttr(datasourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(datasourceName, "tags.Name", rName),
+				),
+			},
+			{
+				Config:      testAccDomainDataSourceConfig_tagsNoMatch(rName),
+				ExpectError: regexache.MustCompile(`no matching Elasticsearch Domain found`),
+			},
+		},
+	})
+}
+
 func TestAccElasticsearchDomainDataSource_advanced(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -95,6 +134,7 @@ func TestAccElasticsearchDomainDataSource_advanced(t *testing.T) {
 					resource.TestCheckResourceAttrPair(datasourceName, "vpc_options.#", resourceName, "vpc_options.#"),
 					resource.TestCheckResourceAttrPair(datasourceName, "advanced_security_options.0.enabled", resourceName, "advanced_security_options.0.enabled"),
 					resource.TestCheckResourceAttrPair(datasourceName, "advanced_security_options.0.internal_user_database_enabled", resourceName, "advanced_security_options.0.internal_user_database_enabled"),
+					resource.TestCheckResourceAttrPair(datasourceName, "advanced_security_options.0.anonymous_auth_enabled", resourceName, "advanced_security_options.0.anonymous_auth_enabled"),
 				),
 			},
 		},
@@ -328,3 +368,39 @@ data "aws_elasticsearch_domain" "test" {
 }
 `, rName, autoTuneStartAtTime))
 }
+
+func testAccDomainDataSourceConfig_tagsBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "test" {
+  domain_name           = %[1]q
+  elasticsearch_version = "6.7"
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccDomainDataSourceConfig_tags(rName string) string {
+	return acctest.ConfigCompose(testAccDomainDataSourceConfig_tagsBase(rName), `
+data "aws_elasticsearch_domain" "test" {
+  tags = aws_elasticsearch_domain.test.tags
+}
+`)
+}
+
+func testAccDomainDataSourceConfig_tagsNoMatch(rName string) string {
+	return acctest.ConfigCompose(testAccDomainDataSourceConfig_tagsBase(rName), `
+data "aws_elasticsearch_domain" "test" {
+  tags = {
+    Name = "${aws_elasticsearch_domain.test.domain_name}-nonexistent"
+  }
+}
+`)
+}
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	domainPackageAssociationResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_elasticsearch_domain_package_association", name="Domain Package Association")
+func resourceDomainPackageAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDomainPackageAssociationCreate,
+		ReadWithoutTimeout:   resourceDomainPackageAssociationRead,
+		DeleteWithoutTimeout: resourceDomainPackageAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDomainName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"package_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"package_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reference_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDomainPackageAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	domainName := d.Get(names.AttrDomainName).(string)
+	packageID := d.Get("package_id").(string)
+	id, err := flex.FlattenResourceId([]string{domainName, packageID}, domainPackageAssociationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &elasticsearchservice.AssociatePackageInput{
+		DomainName: aws.String(domainName),
+		PackageID:  aws.String(packageID),
+	}
+
+	_, err = conn.AssociatePackage(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Elasticsearch Domain Package Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitDomainPackageAssociationCreated(ctx, conn, domainName, packageID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Elasticsearch Domain Package Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDomainPackageAssociationRead(ctx, d, meta)...)
+}
+
+func resourceDomainPackageAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), domainPackageAssociationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domainName, packageID := parts[0], parts[1]
+	pkgAssociation, err := findDomainPackageAssociationByTwoPartKey(ctx, conn, domainName, packageID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Elasticsearch Domain Package Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elasticsearch Domain Package Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrDomainName, pkgAssociation.DomainName)
+	d.Set("package_id", pkgAssociation.PackageID)
+	d.Set("package_type", pkgAssociation.PackageType)
+	d.Set("reference_path", pkgAssociation.ReferencePath)
+
+	return diags
+}
+
+func resourceDomainPackageAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), domainPackageAssociationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domainName, packageID := parts[0], parts[1]
+	log.Printf("[DEBUG] Deleting Elasticsearch Domain Package Association: %s", d.Id())
+	_, err = conn.DissociatePackage(ctx, &elasticsearchservice.DissociatePackageInput{
+		DomainName: aws.String(domainName),
+		PackageID:  aws.String(packageID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsAErrorMessageContains[*awstypes.ValidationException](err, "Package is not associated to this domain") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Elasticsearch Domain Package Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDomainPackageAssociationDeleted(ctx, conn, domainName, packageID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Elasticsearch Domain Package Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDomainPackageAssociationByTwoPartKey(ctx context.Context, conn *elasticsearchservice.Client, domainName, packageID string) (*awstypes.DomainPackageDetails, error) {
+	input := &elasticsearchservice.ListPackagesForDomainInput{
+		DomainName: aws.String(domainName),
+	}
+	filter := func(v awstypes.DomainPackageDetails) bool {
+		return aws.ToString(v.PackageID) == packageID
+	}
+
+	return findDomainPackageAssociation(ctx, conn, input, filter)
+}
+
+func findDomainPackageAssociation(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.ListPackagesForDomainInput, filter tfslices.Predicate[awstypes.DomainPackageDetails]) (*awstypes.DomainPackageDetails, error) {
+	output, err := findDomainPackageAssociations(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findDomainPackageAssociations(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.ListPackagesForDomainInput, filter tfslices.Predicate[awstypes.DomainPackageDetails]) ([]awstypes.DomainPackageDetails, error) {
+	var output []awstypes.DomainPackageDetails
+
+	pages := elasticsearchservice.NewListPackagesForDomainPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.DomainPackageDetailsList {
+			if filter(v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func statusDomainPackageAssociation(ctx context.Context, conn *elasticsearchservice.Client, domainName, packageID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDomainPackageAssociationByTwoPartKey(ctx, conn, domainName, packageID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DomainPackageStatus), nil
+	}
+}
+
+func waitDomainPackageAssociationCreated(ctx context.Context, conn *elasticsearchservice.Client, domainName, packageID string, timeout time.Duration) (*awstypes.DomainPackageDetails, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DomainPackageStatusAssociating),
+		Target:  enum.Slice(awstypes.DomainPackageStatusActive),
+		Refresh: statusDomainPackageAssociation(ctx, conn, domainName, packageID),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DomainPackageDetails); ok {
+		if status, details := output.DomainPackageStatus, output.ErrorDetails; status == awstypes.DomainPackageStatusAssociationFailed && details != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(details.ErrorType), aws.ToString(details.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDomainPackageAssociationDeleted(ctx context.Context, conn *elasticsearchservice.Client, domainName, packageID string, timeout time.Duration) (*awstypes.DomainPackageDetails, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DomainPackageStatusDissociating),
+		Target:  []string{},
+		Refresh: statusDomainPackageAssociation(ctx, conn, domainName, packageID),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DomainPackageDetails); ok {
+		if status, details := output.DomainPackageStatus, output.ErrorDetails; status == awstypes.DomainPackageStatusDissociationFailed && details != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(details.ErrorType), aws.ToString(details.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
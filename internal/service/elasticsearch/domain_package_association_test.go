@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelasticsearch "github.com/hashicorp/terraform-provider-aws/internal/service/elasticsearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccElasticsearchDomainPackageAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := testAccRandomDomainName()
+	pkgName := testAccRandomDomainName()
+	resourceName := "aws_elasticsearch_domain_package_association.test"
+	packageResourceName := "aws_elasticsearch_package.test"
+	domainResourceName := "aws_elasticsearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainPackageAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainPackageAssociationConfig_basic(pkgName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainPackageAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrDomainName, domainResourceName, names.AttrDomainName),
+					resource.TestCheckResourceAttrPair(resourceName, "package_id", packageResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "package_type", "TXT-DICTIONARY"),
+					resource.TestCheckResourceAttrSet(resourceName, "reference_path"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccElasticsearchDomainPackageAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := testAccRandomDomainName()
+	pkgName := testAccRandomDomainName()
+	resourceName := "aws_elasticsearch_domain_package_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainPackageAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainPackageAssociationConfig_basic(pkgName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainPackageAssociationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfelasticsearch.ResourceDomainPackageAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDomainPackageAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchClient(ctx)
+
+		_, err := tfelasticsearch.FindDomainPackageAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrDomainName], rs.Primary.Attributes["package_id"])
+
+		return err
+	}
+}
+
+func testAccCheckDomainPackageAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_elasticsearch_domain_package_association" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchClient(ctx)
+
+			_, err := tfelasticsearch.FindDomainPackageAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrDomainName], rs.Primary.Attributes["package_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Elasticsearch Domain Package Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDomainPackageAssociationConfig_basic(pkgName, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = %[1]q
+  source = "./test-fixtures/example-elasticsearch-custom-package.txt"
+  etag   = filemd5("./test-fixtures/example-elasticsearch-custom-package.txt")
+}
+
+resource "aws_elasticsearch_package" "test" {
+  package_name = %[1]q
+  package_source {
+    s3_bucket_name = aws_s3_bucket.test.bucket
+    s3_key         = aws_s3_object.test.key
+  }
+  package_type = "TXT-DICTIONARY"
+}
+
+resource "aws_elasticsearch_domain" "test" {
+  domain_name           = %[2]q
+  elasticsearch_version = "7.10"
+
+  cluster_config {
+    instance_type = "t3.small.elasticsearch" # supported in both aws and aws-us-gov
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+
+resource "aws_elasticsearch_domain_package_association" "test" {
+  package_id  = aws_elasticsearch_package.test.id
+  domain_name = aws_elasticsearch_domain.test.domain_name
+}
+`, pkgName, domainName)
+}
@@ -5,7 +5,9 @@ package elasticsearch
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -31,6 +33,10 @@ func resourceDomainPolicy() *schema.Resource {
 		UpdateWithoutTimeout: resourceDomainPolicyUpsert,
 		DeleteWithoutTimeout: resourceDomainPolicyDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDomainPolicyImport,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(60 * time.Minute),
@@ -71,7 +77,8 @@ func resourceDomainPolicyUpsert(ctx context.Context, d *schema.ResourceData, met
 		DomainName:     aws.String(domainName),
 	}
 
-	_, err = tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ValidationException](ctx, propagationTimeout,
+	// The domain may still be processing a change made by another resource, e.g. aws_elasticsearch_domain.
+	_, err = tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ValidationException](ctx, d.Timeout(schema.TimeoutUpdate),
 		func() (interface{}, error) {
 			return conn.UpdateElasticsearchDomainConfig(ctx, input)
 		}, "A change/update is in progress")
@@ -122,10 +129,15 @@ func resourceDomainPolicyDelete(ctx context.Context, d *schema.ResourceData, met
 	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
 
 	log.Printf("[DEBUG] Deleting Elasticsearch Domain Policy: %s", d.Id())
-	_, err := conn.UpdateElasticsearchDomainConfig(ctx, &elasticsearch.UpdateElasticsearchDomainConfigInput{
+	input := &elasticsearch.UpdateElasticsearchDomainConfigInput{
 		AccessPolicies: aws.String(""),
 		DomainName:     aws.String(d.Get(names.AttrDomainName).(string)),
-	})
+	}
+
+	_, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ValidationException](ctx, d.Timeout(schema.TimeoutDelete),
+		func() (interface{}, error) {
+			return conn.UpdateElasticsearchDomainConfig(ctx, input)
+		}, "A change/update is in progress")
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return diags
@@ -141,3 +153,37 @@ func resourceDomainPolicyDelete(ctx context.Context, d *schema.ResourceData, met
 
 	return diags
 }
+
+func resourceDomainPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	// Accept either the domain name or the resource ID.
+	// A domain name can itself start with "esd-policy-", so it's tried as given first.
+	domainName := d.Id()
+	dc, err := findDomainConfigByName(ctx, conn, domainName)
+
+	if tfresource.NotFound(err) && strings.HasPrefix(domainName, "esd-policy-") {
+		domainName = strings.TrimPrefix(domainName, "esd-policy-")
+		dc, err = findDomainConfigByName(ctx, conn, domainName)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Elasticsearch Domain (%s) Config: %w", domainName, err)
+	}
+
+	if dc.AccessPolicies == nil || aws.ToString(dc.AccessPolicies.Options) == "" {
+		return nil, fmt.Errorf("Elasticsearch Domain (%s) has no access policy", domainName)
+	}
+
+	policy, err := structure.NormalizeJsonString(aws.ToString(dc.AccessPolicies.Options))
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId("esd-policy-" + domainName)
+	d.Set("access_policies", policy)
+	d.Set(names.AttrDomainName, domainName)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -73,6 +74,119 @@ func TestAccElasticsearchDomainPolicy_basic(t *testing.T) {
 					},
 				),
 			},
+			{
+				ResourceName:       "aws_elasticsearch_domain_policy.main",
+				ImportState:        true,
+				ImportStateId:      name,
+				ImportStateVerify:  true,
+				ImportStatePersist: true,
+			},
+			{
+				Config:   testAccDomainPolicyConfig_basic(ri, policy),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccElasticsearchDomainPolicy_ignoreEquivalent(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domain awstypes.ElasticsearchDomainStatus
+	ri := sdkacctest.RandInt()
+	policy := `{"Version":"2012-10-17","Statement":[{"Sid":"AllowRead","Effect":"Allow","Principal":"*","Action":"es:ESHttpGet","Resource":"${aws_elasticsearch_domain.example.arn}/*","Condition":{"IpAddress":{"aws:SourceIp":"127.0.0.1/32"}}},{"Sid":"AllowWrite","Effect":"Allow","Principal":"*","Action":"es:ESHttpPost","Resource":"${aws_elasticsearch_domain.example.arn}/*","Condition":{"IpAddress":{"aws:SourceIp":"127.0.0.1/32"}}}]}`
+	equivalentPolicy := `{
+    "Statement": [
+        {
+            "Sid":       "AllowWrite",
+            "Resource":  "${aws_elasticsearch_domain.example.arn}/*",
+            "Condition": {
+                "IpAddress": { "aws:SourceIp": [ "127.0.0.1/32" ] }
+            },
+            "Action":    [ "es:ESHttpPost" ],
+            "Principal": "*",
+            "Effect":    "Allow"
+        },
+        {
+            "Sid":       "AllowRead",
+            "Resource":  "${aws_elasticsearch_domain.example.arn}/*",
+            "Condition": {
+                "IpAddress": { "aws:SourceIp": "127.0.0.1/32" }
+            },
+            "Action":    "es:ESHttpGet",
+            "Principal": "*",
+            "Effect":    "Allow"
+        }
+    ],
+    "Version": "2012-10-17"
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainPolicyConfig_basic(ri, policy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, "aws_elasticsearch_domain.example", &domain),
+				),
+			},
+			{
+				Config:   testAccDomainPolicyConfig_basic(ri, equivalentPolicy),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccElasticsearchDomainPolicy_removeWithDomainUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domain awstypes.ElasticsearchDomainStatus
+	ri := sdkacctest.RandInt()
+	policy := `{
+    "Version": "2012-10-17",
+    "Statement": [
+        {
+            "Action": "es:*",
+            "Principal": "*",
+            "Effect": "Allow",
+            "Condition": {
+                "IpAddress": {"aws:SourceIp": "127.0.0.1/32"}
+            },
+            "Resource": "${aws_elasticsearch_domain.example.arn}"
+        }
+    ]
+}`
+	resourceName := "aws_elasticsearch_domain.example"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainPolicyConfig_basic(ri, policy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+				),
+			},
+			{
+				// Remove the policy while the domain is reconfigured in the same apply.
+				Config: testAccDomainPolicyConfig_domainOnly(ri, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.volume_size", "20"),
+					func(s *terraform.State) error {
+						if v := aws.ToString(domain.AccessPolicies); v != "" {
+							return fmt.Errorf("Elasticsearch Domain has access policy: %s", v)
+						}
+
+						return nil
+					},
+				),
+			},
 		},
 	})
 }
@@ -113,3 +227,21 @@ POLICIES
 }
 `, randInt, policy)
 }
+
+func testAccDomainPolicyConfig_domainOnly(randInt, volumeSize int) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "example" {
+  domain_name           = "tf-test-%[1]d"
+  elasticsearch_version = "2.3"
+
+  cluster_config {
+    instance_type = "t2.small.elasticsearch" # supported in both aws and aws-us-gov
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = %[2]d
+  }
+}
+`, randInt, volumeSize)
+}
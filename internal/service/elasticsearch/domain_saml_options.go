@@ -5,7 +5,12 @@ package elasticsearch
 
 import (
 	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -30,9 +35,11 @@ func resourceDomainSAMLOptions() *schema.Resource {
 		DeleteWithoutTimeout: resourceDomainSAMLOptionsDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDomainSAMLOptionsImport,
 		},
 
+		CustomizeDiff: customizeDiffSAMLOptionsKeys,
+
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(60 * time.Minute),
@@ -68,7 +75,7 @@ func resourceDomainSAMLOptions() *schema.Resource {
 									"metadata_content": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
+										ValidateFunc: validateSAMLMetadataContent,
 									},
 								},
 							},
@@ -189,6 +196,77 @@ func resourceDomainSAMLOptionsDelete(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
+func resourceDomainSAMLOptionsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	domainName := d.Id()
+	if _, err := findDomainSAMLOptionByDomainName(ctx, conn, domainName); err != nil {
+		return nil, fmt.Errorf("reading Elasticsearch Domain SAML Options (%s): %w", domainName, err)
+	}
+
+	d.Set(names.AttrDomainName, domainName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// customizeDiffSAMLOptionsKeys requires subject_key and roles_key when SAML authentication is enabled.
+func customizeDiffSAMLOptionsKeys(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("saml_options.0.enabled") || !d.Get("saml_options.0.enabled").(bool) {
+		return nil
+	}
+
+	for _, k := range []string{"subject_key", "roles_key"} {
+		key := "saml_options.0." + k
+		if d.NewValueKnown(key) && d.Get(key).(string) == "" {
+			return fmt.Errorf("saml_options.0.%s must be set when SAML authentication is enabled", k)
+		}
+	}
+
+	return nil
+}
+
+// validateSAMLMetadataContent checks that the IdP metadata is a well-formed XML document.
+func validateSAMLMetadataContent(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if err := checkWellFormedXML(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be well-formed XML: %w", k, err))
+	}
+
+	return
+}
+
+func checkWellFormedXML(s string) error {
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	var hasRoot bool
+
+	for {
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if _, ok := token.(xml.StartElement); ok {
+			hasRoot = true
+		}
+	}
+
+	if !hasRoot {
+		return errors.New("no root element")
+	}
+
+	return nil
+}
+
 func domainSamlOptionsDiffSupress(k, old, new string, d *schema.ResourceData) bool {
 	if v, ok := d.Get("saml_options").([]interface{}); ok && len(v) > 0 {
 		if enabled, ok := v[0].(map[string]interface{})[names.AttrEnabled].(bool); ok && !enabled {
@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -39,6 +41,8 @@ func TestAccElasticsearchDomainSAMLOptions_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "saml_options.0.enabled", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "saml_options.0.idp.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "saml_options.0.idp.0.entity_id", idpEntityId),
+					resource.TestCheckResourceAttr(resourceName, "saml_options.0.roles_key", "Role"),
+					resource.TestCheckResourceAttr(resourceName, "saml_options.0.subject_key", "Subject"),
 				),
 			},
 			{
@@ -123,6 +127,11 @@ func TestAccElasticsearchDomainSAMLOptions_Update(t *testing.T) {
 			},
 			{
 				Config: testAccDomainSAMLOptionsConfig_update(rUserName, rName, idpEntityId),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainSAMLOptionsExist(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "saml_options.#", "1"),
@@ -166,6 +175,75 @@ func TestAccElasticsearchDomainSAMLOptions_Disabled(t *testing.T) {
 	})
 }
 
+func TestAccElasticsearchDomainSAMLOptions_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("acc-test")
+	idpEntityId := fmt.Sprintf("https://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainSAMLOptionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainSAMLOptionsConfig_validation(rName, idpEntityId, `"<EntityDescriptor>"`, `"Role"`, `"Subject"`),
+				ExpectError: regexache.MustCompile(`must be well-formed XML`),
+			},
+			{
+				Config:      testAccDomainSAMLOptionsConfig_validation(rName, idpEntityId, `templatefile("./test-fixtures/saml-metadata.xml.tpl", { entity_id = "`+idpEntityId+`" })`, `"Role"`, `""`),
+				ExpectError: regexache.MustCompile(`saml_options.0.subject_key must be set when SAML authentication is enabled`),
+			},
+			{
+				Config:      testAccDomainSAMLOptionsConfig_validation(rName, idpEntityId, `templatefile("./test-fixtures/saml-metadata.xml.tpl", { entity_id = "`+idpEntityId+`" })`, `null`, `"Subject"`),
+				ExpectError: regexache.MustCompile(`saml_options.0.roles_key must be set when SAML authentication is enabled`),
+			},
+		},
+	})
+}
+
+func TestValidateSAMLMetadataContent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		value     string
+		expectErr bool
+	}{
+		{
+			name:  "valid",
+			value: `<?xml version="1.0"?><EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://example.com"></EntityDescriptor>`,
+		},
+		{
+			name:      "unclosed element",
+			value:     `<EntityDescriptor>`,
+			expectErr: true,
+		},
+		{
+			name:      "mismatched element",
+			value:     `<EntityDescriptor></IDPSSODescriptor>`,
+			expectErr: true,
+		},
+		{
+			name:      "not XML",
+			value:     `metadata`,
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := tfelasticsearch.ValidateSAMLMetadataContent(testCase.value, "metadata_content")
+
+			if got, want := len(errs) > 0, testCase.expectErr; got != want {
+				t.Errorf("errs = %v, expectErr %v", errs, want)
+			}
+		})
+	}
+}
+
 func testAccCheckDomainSAMLOptionsDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -259,6 +337,8 @@ resource "aws_elasticsearch_domain_saml_options" "main" {
       entity_id        = %[3]q
       metadata_content = templatefile("./test-fixtures/saml-metadata.xml.tpl", { entity_id = %[3]q })
     }
+    roles_key   = "Role"
+    subject_key = "Subject"
   }
 }
 `, userName, domainName, idpEntityId)
@@ -316,6 +396,8 @@ resource "aws_elasticsearch_domain_saml_options" "main" {
       entity_id        = %[3]q
       metadata_content = templatefile("./test-fixtures/saml-metadata.xml.tpl", { entity_id = %[3]q })
     }
+    roles_key   = "Role"
+    subject_key = "Subject"
     session_timeout_minutes = 180
   }
 }
@@ -374,3 +456,21 @@ resource "aws_elasticsearch_domain_saml_options" "main" {
 }
 `, userName, domainName)
 }
+
+func testAccDomainSAMLOptionsConfig_validation(domainName, idpEntityId, metadataContent, rolesKey, subjectKey string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain_saml_options" "main" {
+  domain_name = %[1]q
+
+  saml_options {
+    enabled = true
+    idp {
+      entity_id        = %[2]q
+      metadata_content = %[3]s
+    }
+    roles_key   = %[4]s
+    subject_key = %[5]s
+  }
+}
+`, domainName, idpEntityId, metadataContent, rolesKey, subjectKey)
+}
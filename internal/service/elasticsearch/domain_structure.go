@@ -20,6 +20,10 @@ func expandAdvancedSecurityOptions(m []interface{}) *awstypes.AdvancedSecurityOp
 		config.Enabled = aws.Bool(advancedSecurityEnabled.(bool))
 
 		if advancedSecurityEnabled.(bool) {
+			if v, ok := group["anonymous_auth_enabled"].(bool); ok {
+				config.AnonymousAuthEnabled = aws.Bool(v)
+			}
+
 			if v, ok := group["internal_user_database_enabled"].(bool); ok {
 				config.InternalUserDatabaseEnabled = aws.Bool(v)
 			}
@@ -180,6 +184,9 @@ func flattenAdvancedSecurityOptions(advancedSecurityOptions *awstypes.AdvancedSe
 	m := map[string]interface{}{}
 	m[names.AttrEnabled] = aws.ToBool(advancedSecurityOptions.Enabled)
 	if aws.ToBool(advancedSecurityOptions.Enabled) {
+		if v := advancedSecurityOptions.AnonymousAuthEnabled; v != nil {
+			m["anonymous_auth_enabled"] = aws.ToBool(v)
+		}
 		m["internal_user_database_enabled"] = aws.ToBool(advancedSecurityOptions.InternalUserDatabaseEnabled)
 	}
 
@@ -240,14 +247,7 @@ func flattenSAMLOptionsOutput(d *schema.ResourceData, apiObject *awstypes.SAMLOp
 		return nil
 	}
 
-	tfMap := map[string]interface{}{
-		names.AttrEnabled: aws.ToBool(apiObject.Enabled),
-		"idp":             flattenSAMLIdp(apiObject.Idp),
-	}
-
-	tfMap["roles_key"] = aws.ToString(apiObject.RolesKey)
-	tfMap["session_timeout_minutes"] = aws.ToInt32(apiObject.SessionTimeoutMinutes)
-	tfMap["subject_key"] = aws.ToString(apiObject.SubjectKey)
+	tfMap := flattenSAMLOptions(apiObject)
 
 	// samlOptions.master_backend_role and samlOptions.master_user_name will be added to the
 	// all_access role in kibana's security manager.  These values cannot be read or
@@ -259,6 +259,23 @@ func flattenSAMLOptionsOutput(d *schema.ResourceData, apiObject *awstypes.SAMLOp
 	return []interface{}{tfMap}
 }
 
+func flattenSAMLOptions(apiObject *awstypes.SAMLOptionsOutput) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrEnabled: aws.ToBool(apiObject.Enabled),
+		"idp":             flattenSAMLIdp(apiObject.Idp),
+	}
+
+	tfMap["roles_key"] = aws.ToString(apiObject.RolesKey)
+	tfMap["session_timeout_minutes"] = aws.ToInt32(apiObject.SessionTimeoutMinutes)
+	tfMap["subject_key"] = aws.ToString(apiObject.SubjectKey)
+
+	return tfMap
+}
+
 func flattenSAMLIdp(apiObject *awstypes.SAMLIdp) []interface{} {
 	if apiObject == nil {
 		return []interface{}{}
@@ -13,8 +13,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	elasticsearch "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -23,6 +26,202 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestDomainProcessingStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		domain   *awstypes.ElasticsearchDomainStatus
+		expected awstypes.DomainProcessingStatusType
+	}{
+		{
+			name:     "active",
+			domain:   &awstypes.ElasticsearchDomainStatus{DomainProcessingStatus: awstypes.DomainProcessingStatusTypeActive, Processing: aws.Bool(false), UpgradeProcessing: aws.Bool(false)},
+			expected: awstypes.DomainProcessingStatusTypeActive,
+		},
+		{
+			name:     "active processing",
+			domain:   &awstypes.ElasticsearchDomainStatus{DomainProcessingStatus: awstypes.DomainProcessingStatusTypeActive, Processing: aws.Bool(true)},
+			expected: awstypes.DomainProcessingStatusTypeModifying,
+		},
+		{
+			name:     "active upgrade processing",
+			domain:   &awstypes.ElasticsearchDomainStatus{DomainProcessingStatus: awstypes.DomainProcessingStatusTypeActive, Processing: aws.Bool(true), UpgradeProcessing: aws.Bool(true)},
+			expected: awstypes.DomainProcessingStatusTypeUpgrading,
+		},
+		{
+			name:     "no status processing",
+			domain:   &awstypes.ElasticsearchDomainStatus{Processing: aws.Bool(true)},
+			expected: awstypes.DomainProcessingStatusTypeModifying,
+		},
+		{
+			name:     "no status",
+			domain:   &awstypes.ElasticsearchDomainStatus{},
+			expected: awstypes.DomainProcessingStatusTypeActive,
+		},
+		{
+			name:     "creating",
+			domain:   &awstypes.ElasticsearchDomainStatus{DomainProcessingStatus: awstypes.DomainProcessingStatusTypeCreating, Processing: aws.Bool(true)},
+			expected: awstypes.DomainProcessingStatusTypeCreating,
+		},
+		{
+			name:     "deleted",
+			domain:   &awstypes.ElasticsearchDomainStatus{DomainProcessingStatus: awstypes.DomainProcessingStatusTypeActive, Deleted: aws.Bool(true)},
+			expected: awstypes.DomainProcessingStatusTypeDeleting,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfelasticsearch.DomainProcessingStatus(testCase.domain), string(testCase.expected); got != want {
+				t.Errorf("DomainProcessingStatus() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestInPlaceEncryptionEnableVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		version  string
+		expected bool
+	}{
+		{version: "5.6", expected: false},
+		{version: "6.0", expected: false},
+		{version: "6.5", expected: false},
+		{version: "6.7", expected: true},
+		{version: "6.8", expected: true},
+		{version: "7.10", expected: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.version, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfelasticsearch.InPlaceEncryptionEnableVersion(testCase.version), testCase.expected; got != want {
+				t.Errorf("InPlaceEncryptionEnableVersion(%q) = %t, want %t", testCase.version, got, want)
+			}
+		})
+	}
+}
+
+func TestValidateVPCOptionsSecurityGroupIDs(t *testing.T) {
+	t.Parallel()
+
+	vpcOptionsType := cty.List(cty.Object(map[string]cty.Type{
+		names.AttrSecurityGroupIDs: cty.Set(cty.String),
+		names.AttrSubnetIDs:        cty.Set(cty.String),
+	}))
+	vpcOptions := func(securityGroupIDs cty.Value) cty.Value {
+		return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			names.AttrSecurityGroupIDs: securityGroupIDs,
+			names.AttrSubnetIDs:        cty.SetVal([]cty.Value{cty.StringVal("subnet-12345678")}),
+		})})
+	}
+
+	testCases := []struct {
+		name       string
+		vpcOptions cty.Value
+		expectErr  bool
+	}{
+		{
+			name:       "vpc_options null",
+			vpcOptions: cty.NullVal(vpcOptionsType),
+		},
+		{
+			name:       "vpc_options empty",
+			vpcOptions: cty.ListValEmpty(vpcOptionsType.ElementType()),
+		},
+		{
+			name:       "vpc_options unknown",
+			vpcOptions: cty.UnknownVal(vpcOptionsType),
+		},
+		{
+			name:       "security_group_ids null",
+			vpcOptions: vpcOptions(cty.NullVal(cty.Set(cty.String))),
+		},
+		{
+			name:       "security_group_ids unknown",
+			vpcOptions: vpcOptions(cty.UnknownVal(cty.Set(cty.String))),
+		},
+		{
+			name:       "security_group_ids empty",
+			vpcOptions: vpcOptions(cty.SetValEmpty(cty.String)),
+			expectErr:  true,
+		},
+		{
+			name:       "security_group_ids single",
+			vpcOptions: vpcOptions(cty.SetVal([]cty.Value{cty.StringVal("sg-12345678")})),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfelasticsearch.ValidateVPCOptionsSecurityGroupIDs(testCase.vpcOptions)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Errorf("err = %v, expectErr %v", err, want)
+			}
+		})
+	}
+}
+
+func TestVPCOptionsRequiresReplacement(t *testing.T) {
+	t.Parallel()
+
+	vpcOptions := func(securityGroupIDs, subnetIDs []interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{
+			names.AttrSecurityGroupIDs: schema.NewSet(schema.HashString, securityGroupIDs),
+			names.AttrSubnetIDs:        schema.NewSet(schema.HashString, subnetIDs),
+		}}
+	}
+
+	testCases := []struct {
+		name     string
+		o, n     []interface{}
+		expected bool
+	}{
+		{
+			name: "no VPC",
+		},
+		{
+			name:     "non-VPC to VPC",
+			n:        vpcOptions([]interface{}{"sg-1"}, []interface{}{"subnet-1"}),
+			expected: true,
+		},
+		{
+			name:     "VPC to non-VPC",
+			o:        vpcOptions([]interface{}{"sg-1"}, []interface{}{"subnet-1"}),
+			expected: true,
+		},
+		{
+			name: "security groups changed",
+			o:    vpcOptions([]interface{}{"sg-1"}, []interface{}{"subnet-1"}),
+			n:    vpcOptions([]interface{}{"sg-1", "sg-2"}, []interface{}{"subnet-1"}),
+		},
+		{
+			name: "subnets changed",
+			o:    vpcOptions([]interface{}{"sg-1"}, []interface{}{"subnet-1", "subnet-2"}),
+			n:    vpcOptions([]interface{}{"sg-1"}, []interface{}{"subnet-3", "subnet-4"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfelasticsearch.VPCOptionsRequiresReplacement(testCase.o, testCase.n), testCase.expected; got != want {
+				t.Errorf("VPCOptionsRequiresReplacement() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
 func TestAccElasticsearchDomain_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -90,6 +289,33 @@ func TestAccElasticsearchDomain_requireHTTPS(t *testing.T) {
 					testAccCheckDomainEndpointOptions(true, "Policy-Min-TLS-1-2-2019-07", &domain),
 				),
 			},
+			{
+				Config: testAccDomainConfig_endpointOptions(rName, true, "Policy-Min-TLS-1-2-PFS-2023-10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, "aws_elasticsearch_domain.test", &domain),
+					testAccCheckDomainEndpointOptions(true, "Policy-Min-TLS-1-2-PFS-2023-10", &domain),
+					resource.TestCheckResourceAttr("aws_elasticsearch_domain.test", "domain_endpoint_options.0.tls_security_policy", "Policy-Min-TLS-1-2-PFS-2023-10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElasticsearchDomain_customEndpointCertificateARNRequired(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccRandomDomainName()
+	customEndpoint := fmt.Sprintf("%s.example.com", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainConfig_customEndpointNoCertificate(rName, customEndpoint),
+				ExpectError: regexache.MustCompile(`custom_endpoint_certificate_arn must be set`),
+			},
 		},
 	})
 }
@@ -150,6 +376,53 @@ func TestAccElasticsearchDomain_customEndpoint(t *testing.T) {
 	})
 }
 
+func TestAccElasticsearchDomain_customEndpointCertificateRotation(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var domain1, domain2 awstypes.ElasticsearchDomainStatus
+	rName := testAccRandomDomainName()
+	resourceName := "aws_elasticsearch_domain.test"
+	customEndpoint := fmt.Sprintf("%s.example.com", rName)
+	certKey1 := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate1 := acctest.TLSRSAX509SelfSignedCertificatePEM(t, certKey1, customEndpoint)
+	certKey2 := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate2 := acctest.TLSRSAX509SelfSignedCertificatePEM(t, certKey2, customEndpoint)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_customEndpointCertificateRotation(rName, customEndpoint, certKey1, certificate1, certKey2, certificate2, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain1),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_endpoint_options.0.custom_endpoint_certificate_arn", "aws_acm_certificate.test1", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccDomainConfig_customEndpointCertificateRotation(rName, customEndpoint, certKey1, certificate1, certKey2, certificate2, "test2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain2),
+					testAccCheckDomainNotRecreated(&domain1, &domain2),
+					testAccCheckCustomEndpoint(resourceName, true, customEndpoint, &domain2),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_endpoint_options.0.custom_endpoint_certificate_arn", "aws_acm_certificate.test2", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "change_progress.0.status", "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElasticsearchDomain_Cluster_zoneAwareness(t *testing.T) {
 	ctx := acctest.Context(t)
 	var domain1, domain2, domain3, domain4 awstypes.ElasticsearchDomainStatus
@@ -210,6 +483,80 @@ func TestAccElasticsearchDomain_Cluster_zoneAwareness(t *testing.T) {
 	})
 }
 
+func TestAccElasticsearchDomain_Cluster_zoneAwarenessAvailabilityZoneCount(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domain awstypes.ElasticsearchDomainStatus
+	rName := testAccRandomDomainName()
+	resourceName := "aws_elasticsearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIAMServiceLinkedRole(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_clusterZoneAwarenessAvailabilityZoneCount(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "cluster_config.0.zone_awareness_config.0.availability_zone_count", "2"),
+				),
+			},
+			{
+				Config: testAccDomainConfig_clusterZoneAwarenessAvailabilityZoneCount(rName, 3),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "cluster_config.0.zone_awareness_config.0.availability_zone_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_config.0.zone_awareness_enabled", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccDomainConfig_clusterZoneAwarenessAvailabilityZoneCount(rName, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "cluster_config.0.zone_awareness_config.0.availability_zone_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_config.0.zone_awareness_enabled", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccDomainConfig_clusterZoneAwarenessEnabled(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "cluster_config.0.zone_awareness_enabled", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccDomainConfig_clusterZoneAwarenessAvailabilityZoneCount(rName, 3),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "cluster_config.0.zone_awareness_config.0.availability_zone_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_config.0.zone_awareness_enabled", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElasticsearchDomain_warm(t *testing.T) {
 	ctx := acctest.Context(t)
 	var domain awstypes.ElasticsearchDomainStatus
@@ -241,10 +588,11 @@ func TestAccElasticsearchDomain_warm(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateId:     rName,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           rName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"change_progress"},
 			},
 			{
 				Config: testAccDomainConfig_warm(rName, "ultrawarm1.medium.elasticsearch", true, 7),
@@ -268,6 +616,32 @@ func TestAccElasticsearchDomain_warm(t *testing.T) {
 	})
 }
 
+func TestAccElasticsearchDomain_warmValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccRandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainConfig_warmValidation(rName, "6.8", false, 3),
+				ExpectError: regexache.MustCompile(`cluster_config.0.dedicated_master_enabled: must be true when warm_enabled is true`),
+			},
+			{
+				Config:      testAccDomainConfig_warmValidation(rName, "6.8", true, 1),
+				ExpectError: regexache.MustCompile(`cluster_config.0.instance_count: must be at least 2 when warm_enabled is true`),
+			},
+			{
+				Config:      testAccDomainConfig_warmValidation(rName, "6.7", true, 3),
+				ExpectError: regexache.MustCompile(`elasticsearch_version: must be 6.8 or later when cluster_config.0.warm_enabled is true`),
+			},
+		},
+	})
+}
+
 func TestAccElasticsearchDomain_withColdStorageOptions(t *testing.T) {
 	ctx := acctest.Context(t)
 	var domain awstypes.ElasticsearchDomainStatus
@@ -332,6 +706,11 @@ func TestAccElasticsearchDomain_withDedicatedMaster(t *testing.T) {
 			},
 			{
 				Config: testAccDomainConfig_dedicatedClusterMaster(rName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainExists(ctx, resourceName, &domain),
 				),
@@ -346,6 +725,59 @@ func TestAccElasticsearchDomain_withDedicatedMaster(t *testing.T) {
 	})
 }
 
+func TestAccElasticsearchDomain_dedicatedMasterValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccRandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainConfig_dedicatedMasterValidation(rName, 3, ""),
+				ExpectError: regexache.MustCompile(`cluster_config.0.dedicated_master_type: must be set when dedicated_master_enabled is true`),
+			},
+			{
+				Config:      testAccDomainConfig_dedicatedMasterValidation(rName, 2, "t2.small.elasticsearch"),
+				ExpectError: regexache.MustCompile(`cluster_config.0.dedicated_master_count: must be 3 or 5 when dedicated_master_enabled is true \(dedicated_master_count = 2\)`),
+			},
+			{
+				Config:      testAccDomainConfig_dedicatedMasterValidation(rName, 4, "t2.small.elasticsearch"),
+				ExpectError: regexache.MustCompile(`cluster_config.0.dedicated_master_count: must be 3 or 5`),
+			},
+		},
+	})
+}
+
+func TestAccElasticsearchDomain_disableDedicatedMasterWarm(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domain awstypes.ElasticsearchDomainStatus
+	rName := testAccRandomDomainName()
+	resourceName := "aws_elasticsearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIAMServiceLinkedRole(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_warmValidation(rName, "6.8", true, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "cluster_config.0.dedicated_master_enabled", acctest.CtTrue),
+				),
+			},
+			{
+				Config:      testAccDomainConfig_warmValidation(rName, "6.8", false, 3),
+				ExpectError: regexache.MustCompile(`dedicated master nodes cannot be disabled in place on a domain with UltraWarm nodes`),
+			},
+		},
+	})
+}
+
 func TestAccElasticsearchDomain_duplicate(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -486,6 +918,10 @@ func TestAccElasticsearchDomain_vpc(t *testing.T) {
 				Config: testAccDomainConfig_vpc(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_v2", ""),
+					resource.TestMatchResourceAttr(resourceName, "kibana_endpoint", regexache.MustCompile(`.*es\..*/_plugin/kibana/`)),
+					resource.TestCheckResourceAttr(resourceName, "kibana_endpoint_v2", ""),
 				),
 			},
 			{
@@ -525,6 +961,11 @@ func TestAccElasticsearchDomain_VPC_update(t *testing.T) {
 			},
 			{
 				Config: testAccDomainConfig_vpcUpdate2(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainExists(ctx, resourceName, &domain),
 					testAccCheckNumberOfSecurityGroups(2, &domain),
@@ -564,6 +1005,11 @@ func TestAccElasticsearchDomain_internetToVPCEndpoint(t *testing.T) {
 			},
 			{
 				Config: testAccDomainConfig_internetToVPCEndpoint(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainExists(ctx, resourceName, &domain),
 				),
@@ -613,6 +1059,15 @@ func TestAccElasticsearchDomain_AutoTuneOptions(t *testing.T) {
 				ImportStateId:     rName,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccDomainConfig_autoTuneOptionsDisabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "auto_tune_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_tune_options.0.desired_state", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "auto_tune_options.0.rollback_on_disable", "DEFAULT_ROLLBACK"),
+				),
+			},
 		},
 	})
 }
@@ -638,6 +1093,7 @@ func TestAccElasticsearchDomain_AdvancedSecurityOptions_userDB(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainExists(ctx, resourceName, &domain),
 					testAccCheckAdvancedSecurityOptions(true, true, &domain),
+					resource.TestCheckResourceAttr(resourceName, "advanced_security_options.0.anonymous_auth_enabled", acctest.CtFalse),
 				),
 			},
 			{
@@ -693,6 +1149,45 @@ func TestAccElasticsearchDomain_AdvancedSecurityOptions_iam(t *testing.T) {
 	})
 }
 
+func TestAccElasticsearchDomain_AdvancedSecurityOptions_enableUserDB(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var domain1, domain2 awstypes.ElasticsearchDomainStatus
+	rName := testAccRandomDomainName()
+	resourceName := "aws_elasticsearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIAMServiceLinkedRole(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainConfig_advancedSecurityOptionsUserDBNoMasterUser(rName),
+				ExpectError: regexache.MustCompile(`master_user_name must be set when internal_user_database_enabled is true`),
+			},
+			{
+				Config: testAccDomainConfig_advancedSecurityOptionsIAM(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain1),
+					testAccCheckAdvancedSecurityOptions(true, false, &domain1),
+				),
+			},
+			{
+				Config: testAccDomainConfig_advancedSecurityOptionsUserDB(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain2),
+					testAccCheckDomainNotRecreated(&domain1, &domain2),
+					testAccCheckAdvancedSecurityOptions(true, true, &domain2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElasticsearchDomain_AdvancedSecurityOptions_disabled(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -877,6 +1372,24 @@ func TestAccElasticsearchDomain_LogPublishingOptions_auditLogs(t *testing.T) {
 	})
 }
 
+func TestAccElasticsearchDomain_LogPublishingOptions_auditLogsRequireAdvancedSecurity(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccRandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainConfig_logPublishingOptionsAuditLogsNoAdvancedSecurity(rName),
+				ExpectError: regexache.MustCompile(`AUDIT_LOGS can only be enabled when advanced_security_options.0.enabled is true`),
+			},
+		},
+	})
+}
+
 func TestAccElasticsearchDomain_cognitoOptionsCreateAndRemove(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1023,6 +1536,10 @@ func TestAccElasticsearchDomain_policyIgnoreEquivalent(t *testing.T) {
 				Config:   testAccDomainConfig_policyNewOrder(rName),
 				PlanOnly: true,
 			},
+			{
+				Config:   testAccDomainConfig_policyWhitespace(rName),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -1118,6 +1635,11 @@ func TestAccElasticsearchDomain_Encryption_atRestEnable(t *testing.T) {
 			},
 			{
 				Config: testAccDomainConfig_encryptAtRestDefaultKey(rName, "6.7", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainExists(ctx, resourceName, &domain2),
 					testAccCheckDomainEncrypted(true, &domain2),
@@ -1135,6 +1657,46 @@ func TestAccElasticsearchDomain_Encryption_atRestEnable(t *testing.T) {
 	})
 }
 
+func TestAccElasticsearchDomain_Encryption_atRestEnableSpecifyKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var domain awstypes.ElasticsearchDomainStatus
+	rName := testAccRandomDomainName()
+	resourceName := "aws_elasticsearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIAMServiceLinkedRole(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_encryptAtRestDefaultKey(rName, "6.7", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					testAccCheckDomainEncrypted(false, &domain),
+				),
+			},
+			{
+				Config: testAccDomainConfig_encryptAtRestKey(rName, "6.7", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					testAccCheckDomainEncrypted(true, &domain),
+					resource.TestCheckResourceAttrPair(resourceName, "encrypt_at_rest.0.kms_key_id", "aws_kms_key.test", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElasticsearchDomain_Encryption_atRestEnableLegacy(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1160,6 +1722,11 @@ func TestAccElasticsearchDomain_Encryption_atRestEnableLegacy(t *testing.T) {
 			},
 			{
 				Config: testAccDomainConfig_encryptAtRestDefaultKey(rName, "5.6", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainExists(ctx, resourceName, &domain2),
 					testAccCheckDomainEncrypted(true, &domain2),
@@ -1227,6 +1794,11 @@ func TestAccElasticsearchDomain_Encryption_nodeToNodeEnable(t *testing.T) {
 			},
 			{
 				Config: testAccDomainConfig_nodeToNodeEncryption(rName, "6.7", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainExists(ctx, resourceName, &domain2),
 					testAccCheckNodeToNodeEncrypted(true, &domain2),
@@ -1269,6 +1841,11 @@ func TestAccElasticsearchDomain_Encryption_nodeToNodeEnableLegacy(t *testing.T)
 			},
 			{
 				Config: testAccDomainConfig_nodeToNodeEncryption(rName, "6.0", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainExists(ctx, resourceName, &domain2),
 					testAccCheckNodeToNodeEncrypted(true, &domain2),
@@ -1372,6 +1949,9 @@ func TestAccElasticsearchDomain_update(t *testing.T) {
 					testAccCheckDomainExists(ctx, resourceName, &input),
 					testAccCheckNumberOfInstances(4, &input),
 					testAccCheckSnapshotHour(23, &input),
+					resource.TestCheckResourceAttr(resourceName, "change_progress.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "change_progress.0.change_id"),
+					resource.TestCheckResourceAttr(resourceName, "change_progress.0.status", "COMPLETED"),
 				),
 			},
 		}})
@@ -1430,6 +2010,46 @@ func TestAccElasticsearchDomain_VolumeType_update(t *testing.T) {
 		}})
 }
 
+func TestAccElasticsearchDomain_VolumeType_throughput(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var domain1, domain2 awstypes.ElasticsearchDomainStatus
+	rName := testAccRandomDomainName()
+	resourceName := "aws_elasticsearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIAMServiceLinkedRole(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainConfig_clusterEBSVolumeThroughput(rName, "gp2", 250),
+				ExpectError: regexache.MustCompile(`can only be set when volume_type is "gp3"`),
+			},
+			{
+				Config: testAccDomainConfig_clusterEBSVolumeThroughput(rName, "gp3", 125),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain1),
+					testAccCheckEBSVolumeThroughput(125, &domain1),
+					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.throughput", "125"),
+				),
+			},
+			{
+				Config: testAccDomainConfig_clusterEBSVolumeThroughput(rName, "gp3", 250),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain2),
+					testAccCheckDomainNotRecreated(&domain1, &domain2),
+					testAccCheckEBSVolumeThroughput(250, &domain2),
+					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.throughput", "250"),
+				),
+			},
+		}})
+}
+
 // Verifies that EBS volume_type can be changed from gp3 to a type which does not
 // support the throughput and iops input values (ex. gp2)
 //
@@ -1440,7 +2060,52 @@ func TestAccElasticsearchDomain_VolumeType_gp3ToGP2(t *testing.T) {
 		t.Skip("skipping long-running test in short mode")
 	}
 
-	var input awstypes.ElasticsearchDomainStatus
+	var input awstypes.ElasticsearchDomainStatus
+	rName := testAccRandomDomainName()
+	resourceName := "aws_elasticsearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIAMServiceLinkedRole(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_clusterEBSVolumeGP3DefaultIopsThroughput(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "ebs_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.ebs_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.volume_size", "10"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.volume_type", "gp3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     rName,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDomainConfig_clusterEBSVolumeGP2(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "ebs_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.ebs_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.volume_size", "10"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.volume_type", "gp2"),
+				),
+			},
+		}})
+}
+
+func TestAccElasticsearchDomain_VolumeType_gp2ToGP3(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var domain1, domain2, domain3 awstypes.ElasticsearchDomainStatus
 	rName := testAccRandomDomainName()
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -1451,31 +2116,48 @@ func TestAccElasticsearchDomain_VolumeType_gp3ToGP2(t *testing.T) {
 		CheckDestroy:             testAccCheckDomainDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDomainConfig_clusterEBSVolumeGP3DefaultIopsThroughput(rName, 10),
+				Config:      testAccDomainConfig_clusterEBSVolumeIops(rName, "gp2", 3000),
+				ExpectError: regexache.MustCompile(`ebs_options.0.iops: can only be set when volume_type is "gp3" or "io1"`),
+			},
+			{
+				Config: testAccDomainConfig_clusterEBSVolumeGP2(rName, 10),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDomainExists(ctx, resourceName, &input),
-					resource.TestCheckResourceAttr(resourceName, "ebs_options.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.ebs_enabled", acctest.CtTrue),
-					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.volume_size", "10"),
-					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.volume_type", "gp3"),
+					testAccCheckDomainExists(ctx, resourceName, &domain1),
+					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.volume_type", "gp2"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateId:     rName,
-				ImportStateVerify: true,
+				Config: testAccDomainConfig_clusterEBSVolumeGP3IopsThroughput(rName, 3500, 250),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain2),
+					testAccCheckEBSVolumeIops(3500, &domain2),
+					testAccCheckEBSVolumeThroughput(250, &domain2),
+					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.volume_type", "gp3"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.iops", "3500"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.throughput", "250"),
+				),
 			},
 			{
 				Config: testAccDomainConfig_clusterEBSVolumeGP2(rName, 10),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDomainExists(ctx, resourceName, &input),
-					resource.TestCheckResourceAttr(resourceName, "ebs_options.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.ebs_enabled", acctest.CtTrue),
-					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.volume_size", "10"),
+					testAccCheckDomainExists(ctx, resourceName, &domain3),
 					resource.TestCheckResourceAttr(resourceName, "ebs_options.0.volume_type", "gp2"),
 				),
 			},
+			{
+				Config:   testAccDomainConfig_clusterEBSVolumeGP2(rName, 10),
+				PlanOnly: true,
+			},
 		}})
 }
 
@@ -1547,6 +2229,11 @@ func TestAccElasticsearchDomain_Update_version(t *testing.T) {
 			},
 			{
 				Config: testAccDomainConfig_clusterUpdateVersion(rName, "5.6"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainExists(ctx, resourceName, &domain2),
 					testAccCheckDomainNotRecreated(&domain1, &domain2), // note: this check does not work and always passes
@@ -1898,6 +2585,25 @@ resource "aws_elasticsearch_domain" "test" {
 `, rName, autoTuneStartAtTime)
 }
 
+func testAccDomainConfig_autoTuneOptionsDisabled(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "test" {
+  domain_name           = %[1]q
+  elasticsearch_version = "6.7"
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  auto_tune_options {
+    desired_state       = "DISABLED"
+    rollback_on_disable = "DEFAULT_ROLLBACK"
+  }
+}
+`, rName)
+}
+
 func testAccDomainConfig_disabledEBSNullVolumeType(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_elasticsearch_domain" "test" {
@@ -1962,6 +2668,57 @@ resource "aws_elasticsearch_domain" "test" {
 `, rName, enforceHttps, tlsSecurityPolicy, customEndpointEnabled, customEndpoint, acctest.TLSPEMEscapeNewlines(certKey), acctest.TLSPEMEscapeNewlines(certBody))
 }
 
+func testAccDomainConfig_customEndpointCertificateRotation(rName, customEndpoint, certKey1, certBody1, certKey2, certBody2, certName string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test1" {
+  private_key      = "%[3]s"
+  certificate_body = "%[4]s"
+}
+
+resource "aws_acm_certificate" "test2" {
+  private_key      = "%[5]s"
+  certificate_body = "%[6]s"
+}
+
+resource "aws_elasticsearch_domain" "test" {
+  domain_name = %[1]q
+
+  domain_endpoint_options {
+    enforce_https                   = true
+    tls_security_policy             = "Policy-Min-TLS-1-2-2019-07"
+    custom_endpoint_enabled         = true
+    custom_endpoint                 = %[2]q
+    custom_endpoint_certificate_arn = aws_acm_certificate.%[7]s.arn
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+`, rName, customEndpoint, acctest.TLSPEMEscapeNewlines(certKey1), acctest.TLSPEMEscapeNewlines(certBody1), acctest.TLSPEMEscapeNewlines(certKey2), acctest.TLSPEMEscapeNewlines(certBody2), certName)
+}
+
+func testAccDomainConfig_customEndpointNoCertificate(rName, customEndpoint string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "test" {
+  domain_name = %[1]q
+
+  domain_endpoint_options {
+    enforce_https           = true
+    tls_security_policy     = "Policy-Min-TLS-1-2-2019-07"
+    custom_endpoint_enabled = true
+    custom_endpoint         = %[2]q
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+`, rName, customEndpoint)
+}
+
 func testAccDomainConfig_clusterZoneAwarenessAvailabilityZoneCount(rName string, availabilityZoneCount int) string {
 	return fmt.Sprintf(`
 resource "aws_elasticsearch_domain" "test" {
@@ -2042,6 +2799,31 @@ resource "aws_elasticsearch_domain" "test" {
 `, rName, enabled, warmConfig)
 }
 
+func testAccDomainConfig_warmValidation(rName, version string, dedicatedMasterEnabled bool, instanceCount int) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "test" {
+  domain_name           = %[1]q
+  elasticsearch_version = %[2]q
+
+  cluster_config {
+    instance_type            = "c5.large.elasticsearch"
+    instance_count           = %[4]d
+    dedicated_master_enabled = %[3]t
+    dedicated_master_count   = 3
+    dedicated_master_type    = "c5.large.elasticsearch"
+    warm_enabled             = true
+    warm_count               = 2
+    warm_type                = "ultrawarm1.medium.elasticsearch"
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+`, rName, version, dedicatedMasterEnabled, instanceCount)
+}
+
 func testAccDomainConfig_dedicatedClusterMaster(rName string, enabled bool) string {
 	return fmt.Sprintf(`
 resource "aws_elasticsearch_domain" "test" {
@@ -2063,6 +2845,27 @@ resource "aws_elasticsearch_domain" "test" {
 `, rName, enabled)
 }
 
+func testAccDomainConfig_dedicatedMasterValidation(rName string, count int, masterType string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "test" {
+  domain_name = %[1]q
+
+  cluster_config {
+    instance_type            = "t2.small.elasticsearch"
+    instance_count           = 1
+    dedicated_master_enabled = true
+    dedicated_master_count   = %[2]d
+    dedicated_master_type    = %[3]q
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+`, rName, count, masterType)
+}
+
 func testAccDomainConfig_coldStorageOptions(rName string, dMasterEnabled bool, warmEnabled bool, csEnabled bool) string {
 	warmConfig := ""
 	if warmEnabled {
@@ -2186,6 +2989,67 @@ resource "aws_elasticsearch_domain" "test" {
 `, rName, volumeSize)
 }
 
+func testAccDomainConfig_clusterEBSVolumeThroughput(rName, volumeType string, throughput int) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "test" {
+  domain_name           = %[1]q
+  elasticsearch_version = "7.10"
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+    volume_type = %[2]q
+    throughput  = %[3]d
+  }
+
+  cluster_config {
+    instance_type = "t3.small.elasticsearch"
+  }
+}
+`, rName, volumeType, throughput)
+}
+
+func testAccDomainConfig_clusterEBSVolumeGP3IopsThroughput(rName string, iops, throughput int) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "test" {
+  domain_name           = %[1]q
+  elasticsearch_version = "7.10"
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+    volume_type = "gp3"
+    iops        = %[2]d
+    throughput  = %[3]d
+  }
+
+  cluster_config {
+    instance_type = "t3.small.elasticsearch"
+  }
+}
+`, rName, iops, throughput)
+}
+
+func testAccDomainConfig_clusterEBSVolumeIops(rName, volumeType string, iops int) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "test" {
+  domain_name           = %[1]q
+  elasticsearch_version = "7.10"
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+    volume_type = %[2]q
+    iops        = %[3]d
+  }
+
+  cluster_config {
+    instance_type = "t3.small.elasticsearch"
+  }
+}
+`, rName, volumeType, iops)
+}
+
 func testAccDomainConfig_clusterEBSVolumeGP2(rName string, volumeSize int) string {
 	return fmt.Sprintf(`
 resource "aws_elasticsearch_domain" "test" {
@@ -2427,6 +3291,58 @@ data "aws_iam_policy_document" "test" {
 `, rName)
 }
 
+func testAccDomainConfig_policyWhitespace(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_elasticsearch_domain" "test" {
+  domain_name = %[1]q
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  access_policies = <<POLICY
+{
+  "Statement"   : [
+    {
+      "Resource"  : "arn:${data.aws_partition.current.partition}:es:*",
+      "Action"    : "es:*",
+      "Principal" : {
+        "AWS" : [ "${aws_iam_role.test2.arn}",   "${aws_iam_role.test.arn}" ]
+      },
+      "Effect"    : "Allow"
+    }
+  ],
+  "Version"     : "2012-10-17"
+}
+POLICY
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.test.json
+}
+
+resource "aws_iam_role" "test2" {
+  name               = "%[1]s-2"
+  assume_role_policy = data.aws_iam_policy_document.test.json
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["ec2.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+`, rName)
+}
+
 func testAccDomainConfig_encryptAtRestDefaultKey(rName, version string, enabled bool) string {
 	return fmt.Sprintf(`
 resource "aws_elasticsearch_domain" "test" {
@@ -2878,6 +3794,42 @@ resource "aws_elasticsearch_domain" "test" {
 `, rName)
 }
 
+func testAccDomainConfig_advancedSecurityOptionsUserDBNoMasterUser(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "test" {
+  domain_name           = %[1]q
+  elasticsearch_version = "7.1"
+
+  cluster_config {
+    instance_type = "r5.large.elasticsearch"
+  }
+
+  advanced_security_options {
+    enabled                        = true
+    internal_user_database_enabled = true
+  }
+
+  encrypt_at_rest {
+    enabled = true
+  }
+
+  domain_endpoint_options {
+    enforce_https       = true
+    tls_security_policy = "Policy-Min-TLS-1-2-2019-07"
+  }
+
+  node_to_node_encryption {
+    enabled = true
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+`, rName)
+}
+
 func testAccDomainConfig_advancedSecurityOptionsIAM(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_user" "test" {
@@ -3037,6 +3989,25 @@ resource "aws_elasticsearch_domain" "test" {
 `, rName, auditLogsConfig, logType))
 }
 
+func testAccDomainConfig_logPublishingOptionsAuditLogsNoAdvancedSecurity(rName string) string {
+	return acctest.ConfigCompose(testAccDomain_LogPublishingOptions_BaseConfig(rName), fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "test" {
+  domain_name           = %[1]q
+  elasticsearch_version = "7.1"
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  log_publishing_options {
+    log_type                 = "AUDIT_LOGS"
+    cloudwatch_log_group_arn = aws_cloudwatch_log_group.test.arn
+  }
+}
+`, rName))
+}
+
 func testAccDomainConfig_cognitoOptions(rName string, includeCognitoOptions bool) string {
 	var cognitoOptions string
 	if includeCognitoOptions {
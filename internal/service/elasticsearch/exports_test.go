@@ -4,14 +4,34 @@ package elasticsearch
 
 // Exports for use in tests only.
 var (
-	ResourceDomain            = resourceDomain
-	ResourceDomainPolicy      = resourceDomainPolicy
-	ResourceDomainSAMLOptions = resourceDomainSAMLOptions
-	ResourceVPCEndpoint       = resourceVPCEndpoint
+	ResourceDomain                    = resourceDomain
+	ResourceDomainPackageAssociation  = resourceDomainPackageAssociation
+	ResourceDomainPolicy              = resourceDomainPolicy
+	ResourceDomainSAMLOptions         = resourceDomainSAMLOptions
+	ResourceInboundConnectionAccepter = resourceInboundConnectionAccepter
+	ResourceOutboundConnection        = resourceOutboundConnection
+	ResourcePackage                   = resourcePackage
+	ResourceReservedInstance          = resourceReservedInstance
+	ResourceVPCEndpoint               = resourceVPCEndpoint
 
-	FindDomainByName                 = findDomainByName
-	FindDomainSAMLOptionByDomainName = findDomainSAMLOptionByDomainName
-	FindVPCEndpointByID              = findVPCEndpointByID
-	VPCEndpointsError                = vpcEndpointsError
-	WaitDomainCreated                = waitDomainCreated
+	CheckReservedInstanceNameAvailable       = checkReservedInstanceNameAvailable
+	DomainProcessingStatus                   = domainProcessingStatus
+	FindDomainByName                         = findDomainByName
+	FindDomainPackageAssociationByTwoPartKey = findDomainPackageAssociationByTwoPartKey
+	FindDomainSAMLOptionByDomainName         = findDomainSAMLOptionByDomainName
+	FindInboundConnectionByID                = findInboundConnectionByID
+	FindOutboundConnectionByID               = findOutboundConnectionByID
+	FindPackageByID                          = findPackageByID
+	FindReservedInstanceByID                 = findReservedInstanceByID
+	FindVPCEndpointByID                      = findVPCEndpointByID
+	InPlaceEncryptionEnableVersion           = inPlaceEncryptionEnableVersion
+	ValidateSAMLMetadataContent              = validateSAMLMetadataContent
+	ValidateVPCOptionsSecurityGroupIDs       = validateVPCOptionsSecurityGroupIDs
+	VPCEndpointsError                        = vpcEndpointsError
+	VPCOptionsRequiresReplacement            = vpcOptionsRequiresReplacement
+	WaitDomainCreated                        = waitDomainCreated
+)
+
+type (
+	FIPSEndpointOverrideErrorResolverV2 = fipsEndpointOverrideErrorResolverV2
 )
@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_elasticsearch_inbound_connection_accepter", name="Inbound Connection Accepter")
+func resourceInboundConnectionAccepter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInboundConnectionAccepterCreate,
+		ReadWithoutTimeout:   resourceInboundConnectionAccepterRead,
+		DeleteWithoutTimeout: resourceInboundConnectionAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set(names.AttrConnectionID, d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrConnectionID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceInboundConnectionAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	connectionID := d.Get(names.AttrConnectionID).(string)
+	input := &elasticsearchservice.AcceptInboundCrossClusterSearchConnectionInput{
+		CrossClusterSearchConnectionId: aws.String(connectionID),
+	}
+
+	_, err := conn.AcceptInboundCrossClusterSearchConnection(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "accepting Elasticsearch Inbound Connection (%s): %s", connectionID, err)
+	}
+
+	d.SetId(connectionID)
+
+	if _, err := waitInboundConnectionAccepted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Elasticsearch Inbound Connection (%s) accept: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceInboundConnectionAccepterRead(ctx, d, meta)...)
+}
+
+func resourceInboundConnectionAccepterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	connection, err := findInboundConnectionByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Elasticsearch Inbound Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elasticsearch Inbound Connection (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrConnectionID, connection.CrossClusterSearchConnectionId)
+	d.Set("connection_status", connection.ConnectionStatus.StatusCode)
+
+	return diags
+}
+
+func resourceInboundConnectionAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	// Only connections that are still pending acceptance can be rejected.
+	// Approved connections are removed from the destination domain instead.
+	if d.Get("connection_status").(string) == string(awstypes.InboundCrossClusterSearchConnectionStatusCodePendingAcceptance) {
+		log.Printf("[DEBUG] Rejecting Elasticsearch Inbound Connection: %s", d.Id())
+		_, err := conn.RejectInboundCrossClusterSearchConnection(ctx, &elasticsearchservice.RejectInboundCrossClusterSearchConnectionInput{
+			CrossClusterSearchConnectionId: aws.String(d.Id()),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "rejecting Elasticsearch Inbound Connection (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitInboundConnectionRejected(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Elasticsearch Inbound Connection (%s) reject: %s", d.Id(), err)
+		}
+
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Elasticsearch Inbound Connection: %s", d.Id())
+	_, err := conn.DeleteInboundCrossClusterSearchConnection(ctx, &elasticsearchservice.DeleteInboundCrossClusterSearchConnectionInput{
+		CrossClusterSearchConnectionId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Elasticsearch Inbound Connection (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitInboundConnectionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Elasticsearch Inbound Connection (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findInboundConnectionByID(ctx context.Context, conn *elasticsearchservice.Client, id string) (*awstypes.InboundCrossClusterSearchConnection, error) {
+	input := &elasticsearchservice.DescribeInboundCrossClusterSearchConnectionsInput{
+		Filters: []awstypes.Filter{
+			{
+				Name:   aws.String("cross-cluster-search-connection-id"),
+				Values: []string{id},
+			},
+		},
+	}
+
+	output, err := findInboundConnection(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.ConnectionStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.ConnectionStatus.StatusCode; status == awstypes.InboundCrossClusterSearchConnectionStatusCodeDeleted || status == awstypes.InboundCrossClusterSearchConnectionStatusCodeRejected {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findInboundConnection(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.DescribeInboundCrossClusterSearchConnectionsInput) (*awstypes.InboundCrossClusterSearchConnection, error) {
+	output, err := findInboundConnections(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findInboundConnections(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.DescribeInboundCrossClusterSearchConnectionsInput) ([]awstypes.InboundCrossClusterSearchConnection, error) {
+	var output []awstypes.InboundCrossClusterSearchConnection
+
+	pages := elasticsearchservice.NewDescribeInboundCrossClusterSearchConnectionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CrossClusterSearchConnections...)
+	}
+
+	return output, nil
+}
+
+func statusInboundConnection(ctx context.Context, conn *elasticsearchservice.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findInboundConnectionByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ConnectionStatus.StatusCode), nil
+	}
+}
+
+func waitInboundConnectionAccepted(ctx context.Context, conn *elasticsearchservice.Client, id string, timeout time.Duration) (*awstypes.InboundCrossClusterSearchConnection, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.InboundCrossClusterSearchConnectionStatusCodePendingAcceptance),
+		Target:  enum.Slice(awstypes.InboundCrossClusterSearchConnectionStatusCodeApproved),
+		Refresh: statusInboundConnection(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.InboundCrossClusterSearchConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.ConnectionStatus.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInboundConnectionRejected(ctx context.Context, conn *elasticsearchservice.Client, id string, timeout time.Duration) (*awstypes.InboundCrossClusterSearchConnection, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.InboundCrossClusterSearchConnectionStatusCodePendingAcceptance,
+			awstypes.InboundCrossClusterSearchConnectionStatusCodeRejecting,
+		),
+		Target:  []string{},
+		Refresh: statusInboundConnection(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.InboundCrossClusterSearchConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.ConnectionStatus.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInboundConnectionDeleted(ctx context.Context, conn *elasticsearchservice.Client, id string, timeout time.Duration) (*awstypes.InboundCrossClusterSearchConnection, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.InboundCrossClusterSearchConnectionStatusCodeApproved,
+			awstypes.InboundCrossClusterSearchConnectionStatusCodeDeleting,
+		),
+		Target:  []string{},
+		Refresh: statusInboundConnection(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.InboundCrossClusterSearchConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.ConnectionStatus.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelasticsearch "github.com/hashicorp/terraform-provider-aws/internal/service/elasticsearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccElasticsearchInboundConnectionAccepter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var connection awstypes.InboundCrossClusterSearchConnection
+	rName := testAccRandomDomainName()
+	resourceName := "aws_elasticsearch_inbound_connection_accepter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInboundConnectionAccepterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInboundConnectionAccepterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInboundConnectionAccepterExists(ctx, resourceName, &connection),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrConnectionID, "aws_elasticsearch_outbound_connection.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "connection_status", "APPROVED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccElasticsearchInboundConnectionAccepter_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var connection awstypes.InboundCrossClusterSearchConnection
+	rName := testAccRandomDomainName()
+	resourceName := "aws_elasticsearch_inbound_connection_accepter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInboundConnectionAccepterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInboundConnectionAccepterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInboundConnectionAccepterExists(ctx, resourceName, &connection),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfelasticsearch.ResourceInboundConnectionAccepter(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInboundConnectionAccepterExists(ctx context.Context, n string, v *awstypes.InboundCrossClusterSearchConnection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchClient(ctx)
+
+		output, err := tfelasticsearch.FindInboundConnectionByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckInboundConnectionAccepterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_elasticsearch_inbound_connection_accepter" {
+				continue
+			}
+
+			_, err := tfelasticsearch.FindInboundConnectionByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Elasticsearch Inbound Connection %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccInboundConnectionAccepterConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOutboundConnectionConfig_basic(rName), `
+resource "aws_elasticsearch_inbound_connection_accepter" "test" {
+  connection_id = aws_elasticsearch_outbound_connection.test.id
+}
+`)
+}
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	instanceTypeLimitsRoleData   = "data"
+	instanceTypeLimitsRoleMaster = "master"
+)
+
+// @SDKDataSource("aws_elasticsearch_instance_type_limits", name="Instance Type Limits")
+func dataSourceInstanceTypeLimits() *schema.Resource {
+	limitSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"limit_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"limit_values": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInstanceTypeLimitsRead,
+
+		Schema: map[string]*schema.Schema{
+			"additional_limits": limitSchema(),
+			names.AttrDomainName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"elasticsearch_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_limits": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_instance_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"minimum_instance_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrInstanceType: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ESPartitionInstanceType](),
+			},
+			names.AttrRole: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      instanceTypeLimitsRoleData,
+				ValidateFunc: validation.StringInSlice([]string{instanceTypeLimitsRoleData, instanceTypeLimitsRoleMaster}, false),
+			},
+			"storage_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"storage_sub_type_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_type_limits": limitSchema(),
+						"storage_type_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInstanceTypeLimitsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	instanceType, version, role := d.Get(names.AttrInstanceType).(string), d.Get("elasticsearch_version").(string), d.Get(names.AttrRole).(string)
+	input := &elasticsearchservice.DescribeElasticsearchInstanceTypeLimitsInput{
+		ElasticsearchVersion: aws.String(version),
+		InstanceType:         awstypes.ESPartitionInstanceType(instanceType),
+	}
+
+	if v, ok := d.GetOk(names.AttrDomainName); ok {
+		input.DomainName = aws.String(v.(string))
+	}
+
+	limits, err := findInstanceTypeLimitsByRole(ctx, conn, input, role)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Elasticsearch Instance Type Limits", err))
+	}
+
+	d.SetId(strings.Join([]string{instanceType, version, role}, ","))
+	if err := d.Set("additional_limits", flattenAdditionalLimits(limits.AdditionalLimits)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting additional_limits: %s", err)
+	}
+	if err := d.Set("instance_limits", flattenInstanceLimits(limits.InstanceLimits)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instance_limits: %s", err)
+	}
+	if err := d.Set("storage_types", flattenStorageTypes(limits.StorageTypes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting storage_types: %s", err)
+	}
+
+	return diags
+}
+
+func findInstanceTypeLimitsByRole(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.DescribeElasticsearchInstanceTypeLimitsInput, role string) (*awstypes.Limits, error) {
+	output, err := conn.DescribeElasticsearchInstanceTypeLimits(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	limits, ok := output.LimitsByRole[role]
+	if !ok {
+		return nil, &retry.NotFoundError{
+			Message:     fmt.Sprintf("no limits for role %q", role),
+			LastRequest: input,
+		}
+	}
+
+	return &limits, nil
+}
+
+func flattenAdditionalLimits(apiObjects []awstypes.AdditionalLimit) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"limit_name":   aws.ToString(apiObject.LimitName),
+			"limit_values": apiObject.LimitValues,
+		})
+	}
+
+	return tfList
+}
+
+func flattenInstanceLimits(apiObject *awstypes.InstanceLimits) []interface{} {
+	if apiObject == nil || apiObject.InstanceCountLimits == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"maximum_instance_count": apiObject.InstanceCountLimits.MaximumInstanceCount,
+		"minimum_instance_count": apiObject.InstanceCountLimits.MinimumInstanceCount,
+	}}
+}
+
+func flattenStorageTypes(apiObjects []awstypes.StorageType) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		var limits []interface{}
+
+		for _, v := range apiObject.StorageTypeLimits {
+			limits = append(limits, map[string]interface{}{
+				"limit_name":   aws.ToString(v.LimitName),
+				"limit_values": v.LimitValues,
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"storage_sub_type_name": aws.ToString(apiObject.StorageSubTypeName),
+			"storage_type_limits":   limits,
+			"storage_type_name":     aws.ToString(apiObject.StorageTypeName),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccElasticsearchInstanceTypeLimitsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_elasticsearch_instance_type_limits.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceTypeLimitsDataSourceConfig_basic("r5.large.elasticsearch", "7.10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrRole, "data"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_limits.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instance_limits.0.maximum_instance_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instance_limits.0.minimum_instance_count"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "storage_types.*", map[string]string{
+						"storage_type_name":     "ebs",
+						"storage_sub_type_name": "gp2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceTypeLimitsDataSourceConfig_basic(instanceType, version string) string {
+	return fmt.Sprintf(`
+data "aws_elasticsearch_instance_type_limits" "test" {
+  instance_type         = %[1]q
+  elasticsearch_version = %[2]q
+}
+`, instanceType, version)
+}
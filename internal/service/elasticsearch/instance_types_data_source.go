@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_elasticsearch_instance_types", name="Instance Types")
+func dataSourceInstanceTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInstanceTypesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDomainName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"elasticsearch_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceInstanceTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	version := d.Get("elasticsearch_version").(string)
+	input := &elasticsearchservice.ListElasticsearchInstanceTypesInput{
+		ElasticsearchVersion: aws.String(version),
+	}
+
+	if v, ok := d.GetOk(names.AttrDomainName); ok {
+		input.DomainName = aws.String(v.(string))
+	}
+
+	instanceTypes, err := findInstanceTypes(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elasticsearch Instance Types (%s): %s", version, err)
+	}
+
+	d.SetId(version)
+	d.Set("instance_types", enum.Slice(instanceTypes...))
+
+	return diags
+}
+
+func findInstanceTypes(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.ListElasticsearchInstanceTypesInput) ([]awstypes.ESPartitionInstanceType, error) {
+	var output []awstypes.ESPartitionInstanceType
+
+	pages := elasticsearchservice.NewListElasticsearchInstanceTypesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ElasticsearchInstanceTypes...)
+	}
+
+	return output, nil
+}
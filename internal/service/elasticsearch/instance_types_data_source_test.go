@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccElasticsearchInstanceTypesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_elasticsearch_instance_types.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceTypesDataSourceConfig_basic("7.10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "elasticsearch_version", "7.10"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "instance_types.*", "t3.small.elasticsearch"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceTypesDataSourceConfig_basic(version string) string {
+	return fmt.Sprintf(`
+data "aws_elasticsearch_instance_types" "test" {
+  elasticsearch_version = %[1]q
+}
+`, version)
+}
@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_elasticsearch_outbound_connection", name="Outbound Connection")
+func resourceOutboundConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOutboundConnectionCreate,
+		ReadWithoutTimeout:   resourceOutboundConnectionRead,
+		DeleteWithoutTimeout: resourceOutboundConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			outboundConnectionDomainInfoSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrDomainName: {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							names.AttrOwnerID: {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							names.AttrRegion: {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				}
+			}
+
+			return map[string]*schema.Schema{
+				"connection_alias": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"connection_status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"destination_domain_info": outboundConnectionDomainInfoSchema(),
+				"source_domain_info":      outboundConnectionDomainInfoSchema(),
+			}
+		},
+	}
+}
+
+func resourceOutboundConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	connectionAlias := d.Get("connection_alias").(string)
+	input := &elasticsearchservice.CreateOutboundCrossClusterSearchConnectionInput{
+		ConnectionAlias:       aws.String(connectionAlias),
+		DestinationDomainInfo: expandOutboundConnectionDomainInfo(d.Get("destination_domain_info").([]interface{})),
+		SourceDomainInfo:      expandOutboundConnectionDomainInfo(d.Get("source_domain_info").([]interface{})),
+	}
+
+	output, err := conn.CreateOutboundCrossClusterSearchConnection(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Elasticsearch Outbound Connection (%s): %s", connectionAlias, err)
+	}
+
+	d.SetId(aws.ToString(output.CrossClusterSearchConnectionId))
+
+	if _, err := waitOutboundConnectionCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Elasticsearch Outbound Connection (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceOutboundConnectionRead(ctx, d, meta)...)
+}
+
+func resourceOutboundConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	connection, err := findOutboundConnectionByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Elasticsearch Outbound Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elasticsearch Outbound Connection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("connection_alias", connection.ConnectionAlias)
+	d.Set("connection_status", connection.ConnectionStatus.StatusCode)
+	if err := d.Set("destination_domain_info", flattenOutboundConnectionDomainInfo(connection.DestinationDomainInfo)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting destination_domain_info: %s", err)
+	}
+	if err := d.Set("source_domain_info", flattenOutboundConnectionDomainInfo(connection.SourceDomainInfo)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source_domain_info: %s", err)
+	}
+
+	return diags
+}
+
+func resourceOutboundConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Elasticsearch Outbound Connection: %s", d.Id())
+	_, err := conn.DeleteOutboundCrossClusterSearchConnection(ctx, &elasticsearchservice.DeleteOutboundCrossClusterSearchConnectionInput{
+		CrossClusterSearchConnectionId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Elasticsearch Outbound Connection (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitOutboundConnectionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Elasticsearch Outbound Connection (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findOutboundConnectionByID(ctx context.Context, conn *elasticsearchservice.Client, id string) (*awstypes.OutboundCrossClusterSearchConnection, error) {
+	input := &elasticsearchservice.DescribeOutboundCrossClusterSearchConnectionsInput{
+		Filters: []awstypes.Filter{
+			{
+				Name:   aws.String("cross-cluster-search-connection-id"),
+				Values: []string{id},
+			},
+		},
+	}
+
+	output, err := findOutboundConnection(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.ConnectionStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.ConnectionStatus.StatusCode; status == awstypes.OutboundCrossClusterSearchConnectionStatusCodeDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findOutboundConnection(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.DescribeOutboundCrossClusterSearchConnectionsInput) (*awstypes.OutboundCrossClusterSearchConnection, error) {
+	output, err := findOutboundConnections(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findOutboundConnections(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.DescribeOutboundCrossClusterSearchConnectionsInput) ([]awstypes.OutboundCrossClusterSearchConnection, error) {
+	var output []awstypes.OutboundCrossClusterSearchConnection
+
+	pages := elasticsearchservice.NewDescribeOutboundCrossClusterSearchConnectionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CrossClusterSearchConnections...)
+	}
+
+	return output, nil
+}
+
+func statusOutboundConnection(ctx context.Context, conn *elasticsearchservice.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findOutboundConnectionByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ConnectionStatus.StatusCode), nil
+	}
+}
+
+func waitOutboundConnectionCreated(ctx context.Context, conn *elasticsearchservice.Client, id string, timeout time.Duration) (*awstypes.OutboundCrossClusterSearchConnection, error) {
+	// PENDING_ACCEPTANCE is a successful outcome: cross-account connections stay there until the
+	// destination domain owner accepts them. REJECTED and VALIDATION_FAILED surface as errors.
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.OutboundCrossClusterSearchConnectionStatusCodeValidating,
+			awstypes.OutboundCrossClusterSearchConnectionStatusCodeProvisioning,
+		),
+		Target: enum.Slice(
+			awstypes.OutboundCrossClusterSearchConnectionStatusCodePendingAcceptance,
+			awstypes.OutboundCrossClusterSearchConnectionStatusCodeActive,
+		),
+		Refresh: statusOutboundConnection(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.OutboundCrossClusterSearchConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.ConnectionStatus.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOutboundConnectionDeleted(ctx context.Context, conn *elasticsearchservice.Client, id string, timeout time.Duration) (*awstypes.OutboundCrossClusterSearchConnection, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.OutboundCrossClusterSearchConnectionStatusCodeActive,
+			awstypes.OutboundCrossClusterSearchConnectionStatusCodePendingAcceptance,
+			awstypes.OutboundCrossClusterSearchConnectionStatusCodeDeleting,
+		),
+		Target:  []string{},
+		Refresh: statusOutboundConnection(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.OutboundCrossClusterSearchConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.ConnectionStatus.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandOutboundConnectionDomainInfo(tfList []interface{}) *awstypes.DomainInformation {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &awstypes.DomainInformation{
+		DomainName: aws.String(tfMap[names.AttrDomainName].(string)),
+		OwnerId:    aws.String(tfMap[names.AttrOwnerID].(string)),
+		Region:     aws.String(tfMap[names.AttrRegion].(string)),
+	}
+}
+
+func flattenOutboundConnectionDomainInfo(apiObject *awstypes.DomainInformation) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		names.AttrDomainName: aws.ToString(apiObject.DomainName),
+		names.AttrOwnerID:    aws.ToString(apiObject.OwnerId),
+		names.AttrRegion:     aws.ToString(apiObject.Region),
+	}}
+}
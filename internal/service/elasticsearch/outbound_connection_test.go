@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelasticsearch "github.com/hashicorp/terraform-provider-aws/internal/service/elasticsearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccElasticsearchOutboundConnection_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domain awstypes.ElasticsearchDomainStatus
+	var connection awstypes.OutboundCrossClusterSearchConnection
+	rName := testAccRandomDomainName()
+	resourceName := "aws_elasticsearch_outbound_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOutboundConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutboundConnectionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, "aws_elasticsearch_domain.source", &domain),
+					testAccCheckDomainExists(ctx, "aws_elasticsearch_domain.destination", &domain),
+					testAccCheckOutboundConnectionExists(ctx, resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "connection_alias", rName),
+					resource.TestCheckResourceAttr(resourceName, "connection_status", "PENDING_ACCEPTANCE"),
+					resource.TestCheckResourceAttr(resourceName, "destination_domain_info.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_domain_info.0.domain_name", "aws_elasticsearch_domain.destination", names.AttrDomainName),
+					resource.TestCheckResourceAttr(resourceName, "source_domain_info.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_domain_info.0.domain_name", "aws_elasticsearch_domain.source", names.AttrDomainName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccElasticsearchOutboundConnection_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var connection awstypes.OutboundCrossClusterSearchConnection
+	rName := testAccRandomDomainName()
+	resourceName := "aws_elasticsearch_outbound_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOutboundConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutboundConnectionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutboundConnectionExists(ctx, resourceName, &connection),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfelasticsearch.ResourceOutboundConnection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOutboundConnectionExists(ctx context.Context, n string, v *awstypes.OutboundCrossClusterSearchConnection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchClient(ctx)
+
+		output, err := tfelasticsearch.FindOutboundConnectionByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckOutboundConnectionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_elasticsearch_outbound_connection" {
+				continue
+			}
+
+			_, err := tfelasticsearch.FindOutboundConnectionByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Elasticsearch Outbound Connection %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccOutboundConnectionConfig_basic(rName string) string {
+	// Leave room for the domain name suffixes within the 28 character limit.
+	domainName := rName[:26]
+
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "source" {
+  domain_name           = "%[2]s-s"
+  elasticsearch_version = "7.10"
+
+  cluster_config {
+    instance_type = "t3.small.elasticsearch" # supported in both aws and aws-us-gov
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  node_to_node_encryption {
+    enabled = true
+  }
+
+  encrypt_at_rest {
+    enabled = true
+  }
+
+  domain_endpoint_options {
+    enforce_https       = true
+    tls_security_policy = "Policy-Min-TLS-1-2-2019-07"
+  }
+}
+
+resource "aws_elasticsearch_domain" "destination" {
+  domain_name           = "%[2]s-d"
+  elasticsearch_version = "7.10"
+
+  cluster_config {
+    instance_type = "t3.small.elasticsearch" # supported in both aws and aws-us-gov
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  node_to_node_encryption {
+    enabled = true
+  }
+
+  encrypt_at_rest {
+    enabled = true
+  }
+
+  domain_endpoint_options {
+    enforce_https       = true
+    tls_security_policy = "Policy-Min-TLS-1-2-2019-07"
+  }
+}
+
+data "aws_caller_identity" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_elasticsearch_outbound_connection" "test" {
+  connection_alias = %[1]q
+
+  source_domain_info {
+    owner_id    = data.aws_caller_identity.current.account_id
+    region      = data.aws_region.current.name
+    domain_name = aws_elasticsearch_domain.source.domain_name
+  }
+
+  destination_domain_info {
+    owner_id    = data.aws_caller_identity.current.account_id
+    region      = data.aws_region.current.name
+    domain_name = aws_elasticsearch_domain.destination.domain_name
+  }
+}
+`, rName, domainName)
+}
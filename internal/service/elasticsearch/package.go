@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_elasticsearch_package", name="Package")
+func resourcePackage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageCreate,
+		ReadWithoutTimeout:   resourcePackageRead,
+		UpdateWithoutTimeout: resourcePackageUpdate,
+		DeleteWithoutTimeout: resourcePackageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"available_package_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"package_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"package_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrS3BucketName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"s3_key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"package_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.PackageType](),
+			},
+		},
+	}
+}
+
+func resourcePackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	name := d.Get("package_name").(string)
+	input := &elasticsearchservice.CreatePackageInput{
+		PackageDescription: aws.String(d.Get("package_description").(string)),
+		PackageName:        aws.String(name),
+		PackageType:        awstypes.PackageType(d.Get("package_type").(string)),
+	}
+
+	if v, ok := d.GetOk("package_source"); ok {
+		input.PackageSource = expandPackageSource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreatePackage(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Elasticsearch Package (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.PackageDetails.PackageID))
+
+	return append(diags, resourcePackageRead(ctx, d, meta)...)
+}
+
+func resourcePackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	pkg, err := findPackageByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Elasticsearch Package (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elasticsearch Package (%s): %s", d.Id(), err)
+	}
+
+	d.Set("available_package_version", pkg.AvailablePackageVersion)
+	d.Set("package_description", pkg.PackageDescription)
+	d.Set("package_id", pkg.PackageID)
+	d.Set("package_name", pkg.PackageName)
+	d.Set("package_type", pkg.PackageType)
+
+	return diags
+}
+
+func resourcePackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	input := &elasticsearchservice.UpdatePackageInput{
+		PackageID:          aws.String(d.Id()),
+		PackageDescription: aws.String(d.Get("package_description").(string)),
+		PackageSource:      expandPackageSource(d.Get("package_source").([]interface{})[0].(map[string]interface{})),
+	}
+
+	_, err := conn.UpdatePackage(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Elasticsearch Package (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePackageRead(ctx, d, meta)...)
+}
+
+func resourcePackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Elasticsearch Package: %s", d.Id())
+	_, err := conn.DeletePackage(ctx, &elasticsearchservice.DeletePackageInput{
+		PackageID: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsAErrorMessageContains[*awstypes.ValidationException](err, "Package not found") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Elasticsearch Package (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPackageByID(ctx context.Context, conn *elasticsearchservice.Client, id string) (*awstypes.PackageDetails, error) {
+	input := &elasticsearchservice.DescribePackagesInput{
+		Filters: []awstypes.DescribePackagesFilter{
+			{
+				Name:  awstypes.DescribePackagesFilterNamePackageID,
+				Value: []string{id},
+			},
+		},
+	}
+
+	return findPackage(ctx, conn, input)
+}
+
+func findPackage(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.DescribePackagesInput) (*awstypes.PackageDetails, error) {
+	output, err := findPackages(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findPackages(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.DescribePackagesInput) ([]awstypes.PackageDetails, error) {
+	var output []awstypes.PackageDetails
+
+	pages := elasticsearchservice.NewDescribePackagesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsAErrorMessageContains[*awstypes.ValidationException](err, "Package not found") {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.PackageDetailsList...)
+	}
+
+	return output, nil
+}
+
+func expandPackageSource(v interface{}) *awstypes.PackageSource {
+	if v == nil {
+		return nil
+	}
+
+	return &awstypes.PackageSource{
+		S3BucketName: aws.String(v.(map[string]interface{})[names.AttrS3BucketName].(string)),
+		S3Key:        aws.String(v.(map[string]interface{})["s3_key"].(string)),
+	}
+}
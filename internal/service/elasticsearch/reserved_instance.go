@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_elasticsearch_reserved_instance", name="Reserved Instance")
+func resourceReservedInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReservedInstanceCreate,
+		ReadWithoutTimeout:   resourceReservedInstanceRead,
+		DeleteWithoutTimeout: resourceReservedInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDuration: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			names.AttrInstanceCount: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			names.AttrInstanceType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"offering_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"payment_option": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recurring_charges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recurring_charge_amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"recurring_charge_frequency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"reservation_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(5, 64),
+			},
+			names.AttrStartTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"usage_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: customizeDiffReservedInstanceReplacement,
+	}
+}
+
+// customizeDiffReservedInstanceReplacement rejects changes that would replace an existing reservation.
+// A reservation can't be cancelled, so a replacement would purchase a second one while the first keeps being billed.
+func customizeDiffReservedInstanceReplacement(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	for _, key := range []string{names.AttrInstanceCount, "offering_id", "reservation_name"} {
+		if d.HasChange(key) {
+			return fmt.Errorf("changing %s would purchase a new Elasticsearch Reserved Instance; to purchase another reservation, remove this one from state with `terraform state rm` first", key)
+		}
+	}
+
+	return nil
+}
+
+func resourceReservedInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	name := d.Get("reservation_name").(string)
+
+	// customizeDiffReservedInstanceReplacement doesn't see replacements forced by taint or -replace,
+	// nor a reservation that was dropped from state, so check for a reservation in effect before purchasing.
+	if err := checkReservedInstanceNameAvailable(ctx, conn, name); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Elasticsearch Reserved Instance (%s): %s", name, err)
+	}
+
+	input := &elasticsearchservice.PurchaseReservedElasticsearchInstanceOfferingInput{
+		InstanceCount:                           aws.Int32(int32(d.Get(names.AttrInstanceCount).(int))),
+		ReservationName:                         aws.String(name),
+		ReservedElasticsearchInstanceOfferingId: aws.String(d.Get("offering_id").(string)),
+	}
+
+	output, err := conn.PurchaseReservedElasticsearchInstanceOffering(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Elasticsearch Reserved Instance (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ReservedElasticsearchInstanceId))
+
+	// The reservation has been purchased, so keep it in state even if it doesn't become active.
+	if _, err := waitReservedInstanceCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = sdkdiag.AppendWarningf(diags, "waiting for Elasticsearch Reserved Instance (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceReservedInstanceRead(ctx, d, meta)...)
+}
+
+func resourceReservedInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	reservation, err := findReservedInstanceByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Elasticsearch Reserved Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elasticsearch Reserved Instance (%s): %s", d.Id(), err)
+	}
+
+	d.Set("currency_code", reservation.CurrencyCode)
+	d.Set(names.AttrDuration, reservation.Duration)
+	d.Set("fixed_price", reservation.FixedPrice)
+	d.Set(names.AttrInstanceCount, reservation.ElasticsearchInstanceCount)
+	d.Set(names.AttrInstanceType, reservation.ElasticsearchInstanceType)
+	d.Set("offering_id", reservation.ReservedElasticsearchInstanceOfferingId)
+	d.Set("payment_option", reservation.PaymentOption)
+	if err := d.Set("recurring_charges", flattenReservedInstanceRecurringCharges(reservation.RecurringCharges)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting recurring_charges: %s", err)
+	}
+	d.Set("reservation_name", reservation.ReservationName)
+	if reservation.StartTime != nil {
+		d.Set(names.AttrStartTime, reservation.StartTime.Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrStartTime, nil)
+	}
+	d.Set(names.AttrState, reservation.State)
+	d.Set("usage_price", reservation.UsagePrice)
+
+	return diags
+}
+
+func resourceReservedInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Reservations can't be cancelled. They expire at the end of their term.
+	log.Printf("[WARN] Elasticsearch Reserved Instance (%s) can't be cancelled and remains active until the end of its term, removing from state only", d.Id())
+
+	return diags
+}
+
+// checkReservedInstanceNameAvailable returns an error if a reservation with the specified name is pending payment or active.
+func checkReservedInstanceNameAvailable(ctx context.Context, conn *elasticsearchservice.Client, name string) error {
+	input := &elasticsearchservice.DescribeReservedElasticsearchInstancesInput{}
+	reservations, err := findReservedInstances(ctx, conn, input, func(v *awstypes.ReservedElasticsearchInstance) bool {
+		if aws.ToString(v.ReservationName) != name {
+			return false
+		}
+
+		switch aws.ToString(v.State) {
+		case reservedInstanceStateActive, reservedInstanceStatePaymentPending:
+			return true
+		default:
+			return false
+		}
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading Elasticsearch Reserved Instances: %w", err)
+	}
+
+	if len(reservations) > 0 {
+		return fmt.Errorf("reservation (%s) with the same name is %s; import it or choose another reservation_name to purchase another reservation", aws.ToString(reservations[0].ReservedElasticsearchInstanceId), aws.ToString(reservations[0].State))
+	}
+
+	return nil
+}
+
+func findReservedInstanceByID(ctx context.Context, conn *elasticsearchservice.Client, id string) (*awstypes.ReservedElasticsearchInstance, error) {
+	input := &elasticsearchservice.DescribeReservedElasticsearchInstancesInput{
+		ReservedElasticsearchInstanceId: aws.String(id),
+	}
+	output, err := findReservedInstance(ctx, conn, input, tfslices.PredicateTrue[*awstypes.ReservedElasticsearchInstance]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.ReservedElasticsearchInstanceId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findReservedInstance(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.DescribeReservedElasticsearchInstancesInput, filter tfslices.Predicate[*awstypes.ReservedElasticsearchInstance]) (*awstypes.ReservedElasticsearchInstance, error) {
+	output, err := findReservedInstances(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findReservedInstances(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.DescribeReservedElasticsearchInstancesInput, filter tfslices.Predicate[*awstypes.ReservedElasticsearchInstance]) ([]awstypes.ReservedElasticsearchInstance, error) {
+	var output []awstypes.ReservedElasticsearchInstance
+
+	pages := elasticsearchservice.NewDescribeReservedElasticsearchInstancesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ReservedElasticsearchInstances {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func statusReservedInstance(ctx context.Context, conn *elasticsearchservice.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findReservedInstanceByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.State), nil
+	}
+}
+
+func waitReservedInstanceCreated(ctx context.Context, conn *elasticsearchservice.Client, id string, timeout time.Duration) (*awstypes.ReservedElasticsearchInstance, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        []string{reservedInstanceStatePaymentPending},
+		Target:         []string{reservedInstanceStateActive},
+		Refresh:        statusReservedInstance(ctx, conn, id),
+		NotFoundChecks: 5,
+		Timeout:        timeout,
+		MinTimeout:     10 * time.Second,
+		Delay:          30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ReservedElasticsearchInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenReservedInstanceRecurringCharges(apiObjects []awstypes.RecurringCharge) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"recurring_charge_amount":    aws.ToFloat64(apiObject.RecurringChargeAmount),
+			"recurring_charge_frequency": aws.ToString(apiObject.RecurringChargeFrequency),
+		})
+	}
+
+	return tfList
+}
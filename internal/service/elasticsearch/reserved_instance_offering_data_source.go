@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_elasticsearch_reserved_instance_offering", name="Reserved Instance Offering")
+func dataSourceReservedInstanceOffering() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReservedInstanceOfferingRead,
+
+		Schema: map[string]*schema.Schema{
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDuration: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			names.AttrInstanceType: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"offering_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"payment_option": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ReservedElasticsearchInstancePaymentOption](),
+			},
+			"usage_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceReservedInstanceOfferingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	// The API has no filters other than the offering ID, so offerings are filtered here.
+	instanceType := d.Get(names.AttrInstanceType).(string)
+	duration, hasDuration := d.GetOk(names.AttrDuration)
+	paymentOption, hasPaymentOption := d.GetOk("payment_option")
+	filter := func(v *awstypes.ReservedElasticsearchInstanceOffering) bool {
+		if string(v.ElasticsearchInstanceType) != instanceType {
+			return false
+		}
+
+		if hasDuration && int(v.Duration) != duration.(int) {
+			return false
+		}
+
+		if hasPaymentOption && string(v.PaymentOption) != paymentOption.(string) {
+			return false
+		}
+
+		return true
+	}
+
+	offering, err := findReservedInstanceOffering(ctx, conn, &elasticsearchservice.DescribeReservedElasticsearchInstanceOfferingsInput{}, filter)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Elasticsearch Reserved Instance Offering", err))
+	}
+
+	offeringID := aws.ToString(offering.ReservedElasticsearchInstanceOfferingId)
+	d.SetId(offeringID)
+	d.Set("currency_code", offering.CurrencyCode)
+	d.Set(names.AttrDuration, offering.Duration)
+	d.Set("fixed_price", offering.FixedPrice)
+	d.Set(names.AttrInstanceType, offering.ElasticsearchInstanceType)
+	d.Set("offering_id", offeringID)
+	d.Set("payment_option", offering.PaymentOption)
+	d.Set("usage_price", offering.UsagePrice)
+
+	return diags
+}
+
+func findReservedInstanceOffering(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.DescribeReservedElasticsearchInstanceOfferingsInput, filter tfslices.Predicate[*awstypes.ReservedElasticsearchInstanceOffering]) (*awstypes.ReservedElasticsearchInstanceOffering, error) {
+	output, err := findReservedInstanceOfferings(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findReservedInstanceOfferings(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.DescribeReservedElasticsearchInstanceOfferingsInput, filter tfslices.Predicate[*awstypes.ReservedElasticsearchInstanceOffering]) ([]awstypes.ReservedElasticsearchInstanceOffering, error) {
+	var output []awstypes.ReservedElasticsearchInstanceOffering
+
+	pages := elasticsearchservice.NewDescribeReservedElasticsearchInstanceOfferingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ReservedElasticsearchInstanceOfferings {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccElasticsearchReservedInstanceOfferingDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_elasticsearch_reserved_instance_offering.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReservedInstanceOfferingDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "currency_code"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrDuration, "31536000"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "fixed_price"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrInstanceType, "m5.large.elasticsearch"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "offering_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "payment_option", "ALL_UPFRONT"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "usage_price"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReservedInstanceOfferingDataSourceConfig_basic() string {
+	return `
+data "aws_elasticsearch_reserved_instance_offering" "test" {
+  instance_type  = "m5.large.elasticsearch"
+  duration       = 31536000
+  payment_option = "ALL_UPFRONT"
+}
+`
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelasticsearch "github.com/hashicorp/terraform-provider-aws/internal/service/elasticsearch"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestCheckReservedInstanceNameAvailable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		reservations []awstypes.ReservedElasticsearchInstance
+		expectErr    bool
+	}{
+		{
+			name: "no reservations",
+		},
+		{
+			name: "other name",
+			reservations: []awstypes.ReservedElasticsearchInstance{
+				{ReservationName: aws.String("other"), ReservedElasticsearchInstanceId: aws.String("id1"), State: aws.String("active")},
+			},
+		},
+		{
+			name: "same name retired",
+			reservations: []awstypes.ReservedElasticsearchInstance{
+				{ReservationName: aws.String("test"), ReservedElasticsearchInstanceId: aws.String("id1"), State: aws.String("retired")},
+			},
+		},
+		{
+			name: "same name payment failed",
+			reservations: []awstypes.ReservedElasticsearchInstance{
+				{ReservationName: aws.String("test"), ReservedElasticsearchInstanceId: aws.String("id1"), State: aws.String("payment-failed")},
+			},
+		},
+		{
+			name: "same name payment pending",
+			reservations: []awstypes.ReservedElasticsearchInstance{
+				{ReservationName: aws.String("test"), ReservedElasticsearchInstanceId: aws.String("id1"), State: aws.String("payment-pending")},
+			},
+			expectErr: true,
+		},
+		{
+			name: "same name active",
+			reservations: []awstypes.ReservedElasticsearchInstance{
+				{ReservationName: aws.String("other"), ReservedElasticsearchInstanceId: aws.String("id1"), State: aws.String("active")},
+				{ReservationName: aws.String("test"), ReservedElasticsearchInstanceId: aws.String("id2"), State: aws.String("active")},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := elasticsearchservice.New(elasticsearchservice.Options{
+				Region: "us-west-2", //lintignore:AWSAT003
+				APIOptions: []func(*middleware.Stack) error{
+					acctest.StubAPIResponses(func(in any) (any, error) {
+						if _, ok := in.(*elasticsearchservice.DescribeReservedElasticsearchInstancesInput); !ok {
+							return nil, fmt.Errorf("unexpected input type %T", in)
+						}
+
+						return &elasticsearchservice.DescribeReservedElasticsearchInstancesOutput{
+							ReservedElasticsearchInstances: testCase.reservations,
+						}, nil
+					}),
+				},
+			})
+
+			err := tfelasticsearch.CheckReservedInstanceNameAvailable(context.Background(), conn, "test")
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Errorf("err = %v, expectErr %v", err, want)
+			}
+		})
+	}
+}
+
+func TestAccElasticsearchReservedInstance_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "RUN_ELASTICSEARCH_RESERVED_INSTANCE_TESTS"
+	if os.Getenv(key) != acctest.CtTrue {
+		t.Skipf("Environment variable %s is not set to true", key)
+	}
+
+	var reservation awstypes.ReservedElasticsearchInstance
+	rName := testAccRandomDomainName()
+	resourceName := "aws_elasticsearch_reserved_instance.test"
+	dataSourceName := "data.aws_elasticsearch_reserved_instance_offering.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReservedInstanceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReservedInstanceExists(ctx, resourceName, &reservation),
+					resource.TestCheckResourceAttrPair(dataSourceName, "currency_code", resourceName, "currency_code"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDuration, resourceName, names.AttrDuration),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fixed_price", resourceName, "fixed_price"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceCount, "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrInstanceType, resourceName, names.AttrInstanceType),
+					resource.TestCheckResourceAttrPair(dataSourceName, "offering_id", resourceName, "offering_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "payment_option", resourceName, "payment_option"),
+					resource.TestCheckResourceAttr(resourceName, "reservation_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStartTime),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReservedInstanceExists(ctx context.Context, n string, v *awstypes.ReservedElasticsearchInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchClient(ctx)
+
+		output, err := tfelasticsearch.FindReservedInstanceByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccReservedInstanceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_elasticsearch_reserved_instance_offering" "test" {
+  instance_type  = "t3.small.elasticsearch"
+  duration       = 31536000
+  payment_option = "NO_UPFRONT"
+}
+
+resource "aws_elasticsearch_reserved_instance" "test" {
+  offering_id      = data.aws_elasticsearch_reserved_instance_offering.test.offering_id
+  reservation_name = %[1]q
+}
+`, rName)
+}
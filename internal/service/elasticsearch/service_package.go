@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	smithyendpoints "github.com/aws/smithy-go/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// errorOnFIPSEndpointOverrideEnvVar is the environment variable that, when true, makes Elasticsearch API calls fail
+// instead of silently disabling FIPS when a custom endpoint is configured together with use_fips_endpoint.
+const errorOnFIPSEndpointOverrideEnvVar = "TF_AWS_ELASTICSEARCH_ERROR_ON_FIPS_ENDPOINT_OVERRIDE"
+
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*elasticsearchservice.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws.Config))
+
+	var resolver elasticsearchservice.EndpointResolverV2 = newEndpointResolverV2()
+	if v, _ := strconv.ParseBool(os.Getenv(errorOnFIPSEndpointOverrideEnvVar)); v {
+		resolver = fipsEndpointOverrideErrorResolverV2{resolver}
+	}
+
+	return elasticsearchservice.NewFromConfig(cfg,
+		elasticsearchservice.WithEndpointResolverV2(resolver),
+		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+	), nil
+}
+
+// fipsEndpointOverrideErrorResolverV2 returns an error instead of silently disabling FIPS
+// when a custom endpoint is configured together with use_fips_endpoint
+// and TF_AWS_ELASTICSEARCH_ERROR_ON_FIPS_ENDPOINT_OVERRIDE is set.
+type fipsEndpointOverrideErrorResolverV2 struct {
+	elasticsearchservice.EndpointResolverV2
+}
+
+func (r fipsEndpointOverrideErrorResolverV2) ResolveEndpoint(ctx context.Context, params elasticsearchservice.EndpointParameters) (smithyendpoints.Endpoint, error) {
+	if endpoint := aws.ToString(params.Endpoint); endpoint != "" && aws.ToBool(params.UseFIPS) {
+		return smithyendpoints.Endpoint{}, fmt.Errorf("custom endpoint (%s) cannot be used with use_fips_endpoint: remove the endpoint override or unset %s", endpoint, errorOnFIPSEndpointOverrideEnvVar)
+	}
+
+	return r.EndpointResolverV2.ResolveEndpoint(ctx, params)
+}
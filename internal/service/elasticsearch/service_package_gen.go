@@ -5,8 +5,6 @@ package elasticsearch
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -29,6 +27,26 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_elasticsearch_domain",
 			Name:     "Domain",
 		},
+		{
+			Factory:  dataSourceInstanceTypeLimits,
+			TypeName: "aws_elasticsearch_instance_type_limits",
+			Name:     "Instance Type Limits",
+		},
+		{
+			Factory:  dataSourceInstanceTypes,
+			TypeName: "aws_elasticsearch_instance_types",
+			Name:     "Instance Types",
+		},
+		{
+			Factory:  dataSourceReservedInstanceOffering,
+			TypeName: "aws_elasticsearch_reserved_instance_offering",
+			Name:     "Reserved Instance Offering",
+		},
+		{
+			Factory:  dataSourceVPCEndpoint,
+			TypeName: "aws_elasticsearch_vpc_endpoint",
+			Name:     "VPC Endpoint",
+		},
 	}
 }
 
@@ -42,6 +60,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceDomainPackageAssociation,
+			TypeName: "aws_elasticsearch_domain_package_association",
+			Name:     "Domain Package Association",
+		},
 		{
 			Factory:  resourceDomainPolicy,
 			TypeName: "aws_elasticsearch_domain_policy",
@@ -52,6 +75,26 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_elasticsearch_domain_saml_options",
 			Name:     "Domain SAML Options",
 		},
+		{
+			Factory:  resourceInboundConnectionAccepter,
+			TypeName: "aws_elasticsearch_inbound_connection_accepter",
+			Name:     "Inbound Connection Accepter",
+		},
+		{
+			Factory:  resourceOutboundConnection,
+			TypeName: "aws_elasticsearch_outbound_connection",
+			Name:     "Outbound Connection",
+		},
+		{
+			Factory:  resourcePackage,
+			TypeName: "aws_elasticsearch_package",
+			Name:     "Package",
+		},
+		{
+			Factory:  resourceReservedInstance,
+			TypeName: "aws_elasticsearch_reserved_instance",
+			Name:     "Reserved Instance",
+		},
 		{
 			Factory:  resourceVPCEndpoint,
 			TypeName: "aws_elasticsearch_vpc_endpoint",
@@ -64,16 +107,6 @@ func (p *servicePackage) ServicePackageName() string {
 	return names.Elasticsearch
 }
 
-// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
-func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*elasticsearchservice.Client, error) {
-	cfg := *(config["aws_sdkv2_config"].(*aws.Config))
-
-	return elasticsearchservice.NewFromConfig(cfg,
-		elasticsearchservice.WithEndpointResolverV2(newEndpointResolverV2()),
-		withBaseEndpoint(config[names.AttrEndpoint].(string)),
-	), nil
-}
-
 func ServicePackage(ctx context.Context) conns.ServicePackage {
 	return &servicePackage{}
 }
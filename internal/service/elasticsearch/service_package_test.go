@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	tfelasticsearch "github.com/hashicorp/terraform-provider-aws/internal/service/elasticsearch"
+)
+
+func TestFIPSEndpointOverrideErrorResolverV2(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		params    elasticsearchservice.EndpointParameters
+		expectErr bool
+	}{
+		{
+			name: "default endpoint",
+			params: elasticsearchservice.EndpointParameters{
+				Region: aws.String("us-west-2"), //lintignore:AWSAT003
+			},
+		},
+		{
+			name: "FIPS endpoint",
+			params: elasticsearchservice.EndpointParameters{
+				Region:  aws.String("us-west-2"), //lintignore:AWSAT003
+				UseFIPS: aws.Bool(true),
+			},
+		},
+		{
+			name: "custom endpoint",
+			params: elasticsearchservice.EndpointParameters{
+				Endpoint: aws.String("https://es.example.com"),
+				Region:   aws.String("us-west-2"), //lintignore:AWSAT003
+			},
+		},
+		{
+			name: "custom endpoint with FIPS",
+			params: elasticsearchservice.EndpointParameters{
+				Endpoint: aws.String("https://es.example.com"),
+				Region:   aws.String("us-west-2"), //lintignore:AWSAT003
+				UseFIPS:  aws.Bool(true),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			resolver := tfelasticsearch.FIPSEndpointOverrideErrorResolverV2{
+				EndpointResolverV2: elasticsearchservice.NewDefaultEndpointResolverV2(),
+			}
+			_, err := resolver.ResolveEndpoint(context.Background(), testCase.params)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Errorf("err = %v, expectErr %v", err, want)
+			}
+		})
+	}
+}
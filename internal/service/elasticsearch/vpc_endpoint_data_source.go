@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_elasticsearch_vpc_endpoint", name="VPC Endpoint")
+func dataSourceVPCEndpoint() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPCEndpointRead,
+
+		Schema: map[string]*schema.Schema{
+			"domain_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrEndpoint: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_endpoint_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vpc_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAvailabilityZones: {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrSecurityGroupIDs: {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrSubnetIDs: {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrVPCID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVPCEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticsearchClient(ctx)
+
+	domainARN := d.Get("domain_arn").(string)
+	domainName, err := domainNameFromARN(domainARN)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &elasticsearchservice.ListVpcEndpointsForDomainInput{
+		DomainName: aws.String(domainName),
+	}
+	filter := tfslices.PredicateTrue[*awstypes.VpcEndpointSummary]()
+	if v, ok := d.GetOk("vpc_endpoint_id"); ok {
+		vpcEndpointID := v.(string)
+		filter = func(v *awstypes.VpcEndpointSummary) bool {
+			return aws.ToString(v.VpcEndpointId) == vpcEndpointID
+		}
+	}
+
+	summary, err := findVPCEndpointSummaryForDomain(ctx, conn, input, filter)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Elasticsearch VPC Endpoint", err))
+	}
+
+	id := aws.ToString(summary.VpcEndpointId)
+	endpoint, err := findVPCEndpointByID(ctx, conn, id)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elasticsearch VPC Endpoint (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("domain_arn", endpoint.DomainArn)
+	d.Set(names.AttrEndpoint, endpoint.Endpoint)
+	d.Set(names.AttrStatus, endpoint.Status)
+	d.Set("vpc_endpoint_id", endpoint.VpcEndpointId)
+	if endpoint.VpcOptions != nil {
+		if err := d.Set("vpc_options", []interface{}{flattenVPCDerivedInfo(endpoint.VpcOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting vpc_options: %s", err)
+		}
+	} else {
+		d.Set("vpc_options", nil)
+	}
+
+	return diags
+}
+
+func domainNameFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	if name, ok := strings.CutPrefix(v.Resource, "domain/"); ok && name != "" {
+		return name, nil
+	}
+
+	return "", fmt.Errorf("%q is not a valid Elasticsearch Domain ARN", s)
+}
+
+func findVPCEndpointSummaryForDomain(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.ListVpcEndpointsForDomainInput, filter tfslices.Predicate[*awstypes.VpcEndpointSummary]) (*awstypes.VpcEndpointSummary, error) {
+	output, err := findVPCEndpointSummariesForDomain(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findVPCEndpointSummariesForDomain(ctx context.Context, conn *elasticsearchservice.Client, input *elasticsearchservice.ListVpcEndpointsForDomainInput, filter tfslices.Predicate[*awstypes.VpcEndpointSummary]) ([]awstypes.VpcEndpointSummary, error) {
+	var output []awstypes.VpcEndpointSummary
+
+	for {
+		page, err := conn.ListVpcEndpointsForDomain(ctx, input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.VpcEndpointSummaryList {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsearch_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccElasticsearchVPCEndpointDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := testAccRandomDomainName()
+	dataSourceName := "data.aws_elasticsearch_vpc_endpoint.test"
+	resourceName := "aws_elasticsearch_vpc_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointDataSourceConfig_basic(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_arn", resourceName, "domain_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrEndpoint, resourceName, names.AttrEndpoint),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_endpoint_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_options.#", resourceName, "vpc_options.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_options.0.availability_zones.#", resourceName, "vpc_options.0.availability_zones.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_options.0.security_group_ids.#", resourceName, "vpc_options.0.security_group_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_options.0.subnet_ids.#", resourceName, "vpc_options.0.subnet_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_options.0.vpc_id", resourceName, "vpc_options.0.vpc_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCEndpointDataSourceConfig_basic(rName, domainName string) string {
+	return acctest.ConfigCompose(testAccVPCEndpointConfig_basic(rName, domainName), `
+data "aws_elasticsearch_vpc_endpoint" "test" {
+  domain_arn = aws_elasticsearch_vpc_endpoint.test.domain_arn
+}
+`)
+}
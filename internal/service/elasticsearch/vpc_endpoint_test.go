@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -204,6 +205,24 @@ func TestAccElasticsearchVPCEndpoint_update(t *testing.T) {
 	})
 }
 
+// VPC endpoints have no ARN and can't be tagged.
+func TestAccElasticsearchVPCEndpoint_tagsNotSupported(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticsearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCEndpointConfig_tags(),
+				ExpectError: regexache.MustCompile(`An argument named "tags" is not expected here`),
+			},
+		},
+	})
+}
+
 func testAccCheckVPCEndpointExists(ctx context.Context, n string, v *awstypes.VpcEndpoint) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -342,3 +361,20 @@ resource "aws_elasticsearch_vpc_endpoint" "test" {
 }
 `)
 }
+
+// lintignore:AWSAT003,AWSAT005
+func testAccVPCEndpointConfig_tags() string {
+	return `
+resource "aws_elasticsearch_vpc_endpoint" "test" {
+  domain_arn = "arn:aws:es:us-west-2:123456789012:domain/test"
+
+  vpc_options {
+    subnet_ids = ["subnet-12345678"]
+  }
+
+  tags = {
+    Name = "test"
+  }
+}
+`
+}
@@ -9,6 +9,8 @@ var (
 	DiffSuppressKeyOrAlias      = diffSuppressKeyOrAlias
 	FindAliasByName             = findAliasByName
 	FindDefaultKeyARNForService = findDefaultKeyARNForService
+	FindKeyByID                 = findKeyByID
+	IsKeyARN                    = isKeyARN
 	ValidateKey                 = validateKey
 	ValidateKeyOrAlias          = validateKeyOrAlias
 )
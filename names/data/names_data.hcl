@@ -2589,6 +2589,10 @@ service "datazone" {
     human_friendly      = "DataZone"
   }
 
+  client {
+    skip_client_generate = true
+  }
+
   endpoint_info {
     endpoint_api_call = "ListDomains"
   }
@@ -3242,6 +3246,7 @@ service "elasticsearch" {
 
   client {
     go_v1_client_typename = "ElasticsearchService"
+    skip_client_generate  = true
   }
 
   endpoint_info {